@@ -0,0 +1,18 @@
+package analysis
+
+// InterpolatePeak уточняет положение пика по трем отсчетам параболической
+// интерполяцией. y1 - локальный максимум, y0 и y2 - его соседи слева и справа.
+// Возвращает смещение вершины параболы относительно y1 в долях отсчета
+// (в диапазоне [-0.5, 0.5] для настоящего максимума) и значение в вершине.
+// Для спектра БПФ частота пика равна (k + offset) * fs / N; точность выше,
+// если интерполировать логарифм модуля спектра
+func InterpolatePeak(y0, y1, y2 float64) (offset, peak float64) {
+	denom := y0 - 2*y1 + y2
+	if denom == 0 {
+		return 0, y1
+	}
+
+	offset = 0.5 * (y0 - y2) / denom
+	peak = y1 - 0.25*(y0-y2)*offset
+	return offset, peak
+}
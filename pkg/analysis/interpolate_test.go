@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"dsp_go/pkg/fft"
+	"dsp_go/pkg/windows"
+)
+
+// TestInterpolatePeak_Parabola проверяет восстановление вершины параболы
+func TestInterpolatePeak_Parabola(t *testing.T) {
+	tests := []struct {
+		vertex, value, curvature float64
+	}{
+		{0.3, 2, -1},
+		{-0.45, 10, -4},
+		{0, -1, -0.5},
+	}
+
+	for _, tt := range tests {
+		y := func(x float64) float64 {
+			return tt.curvature*(x-tt.vertex)*(x-tt.vertex) + tt.value
+		}
+
+		offset, peak := InterpolatePeak(y(-1), y(0), y(1))
+		if math.Abs(offset-tt.vertex) > 1e-12 {
+			t.Errorf("Смещение: ожидалось %f, получено %f", tt.vertex, offset)
+		}
+		if math.Abs(peak-tt.value) > 1e-12 {
+			t.Errorf("Значение в вершине: ожидалось %f, получено %f", tt.value, peak)
+		}
+	}
+}
+
+// TestInterpolatePeak_Flat проверяет случай трех равных отсчетов
+func TestInterpolatePeak_Flat(t *testing.T) {
+	offset, peak := InterpolatePeak(3, 3, 3)
+	if offset != 0 || peak != 3 {
+		t.Errorf("Ожидалось (0, 3), получено (%f, %f)", offset, peak)
+	}
+}
+
+// TestInterpolatePeak_SpectrumFrequency проверяет уточнение частоты
+// синусоиды между бинами БПФ совместно с FindPeaks
+func TestInterpolatePeak_SpectrumFrequency(t *testing.T) {
+	const (
+		n        = 1024
+		fs       = 8000.0
+		trueFreq = 1234.5
+	)
+
+	window := windows.HannWindow(n)
+	signal := make([]float64, n)
+	for i := range signal {
+		signal[i] = window[i] * math.Sin(2*math.Pi*trueFreq*float64(i)/fs)
+	}
+
+	spectrum := fft.RFFT(signal)
+	logMag := make([]float64, len(spectrum))
+	for i, v := range spectrum {
+		logMag[i] = math.Log(cmplx.Abs(v) + 1e-12)
+	}
+
+	peaks := FindPeaks(logMag, math.Log(10), 10)
+	if len(peaks) != 1 {
+		t.Fatalf("Ожидался 1 пик спектра, получено %v", peaks)
+	}
+
+	k := peaks[0]
+	offset, _ := InterpolatePeak(logMag[k-1], logMag[k], logMag[k+1])
+	estimate := (float64(k) + offset) * fs / n
+
+	binWidth := fs / n
+	if math.Abs(estimate-trueFreq) > 0.05*binWidth {
+		t.Errorf("Частота: ожидалось %.2f Гц, получено %.2f Гц (бин %d)", trueFreq, estimate, k)
+	}
+}
@@ -0,0 +1,55 @@
+// Package analysis содержит утилиты анализа сигналов: поиск пиков и статистики
+package analysis
+
+import "sort"
+
+// FindPeaks находит локальные максимумы сигнала, превышающие порог threshold
+// и отстоящие друг от друга не менее чем на minDistance отсчетов.
+// Если два пика расположены ближе minDistance, сохраняется более высокий.
+// Для плоской вершины берется ее первый отсчет. Индексы возвращаются по возрастанию
+func FindPeaks(signal []float64, threshold, minDistance float64) []int {
+	// Кандидаты: локальные максимумы выше порога
+	var candidates []int
+	for i := 1; i < len(signal)-1; i++ {
+		v := signal[i]
+		if v <= threshold || v <= signal[i-1] {
+			continue
+		}
+
+		// Пропускаем плоскую вершину до первого отличного отсчета
+		j := i + 1
+		for j < len(signal)-1 && signal[j] == v {
+			j++
+		}
+		if signal[j] < v {
+			candidates = append(candidates, i)
+		}
+	}
+
+	if minDistance <= 1 || len(candidates) < 2 {
+		return candidates
+	}
+
+	// Отбор по высоте: более высокие пики подавляют близких соседей
+	byHeight := append([]int{}, candidates...)
+	sort.SliceStable(byHeight, func(a, b int) bool {
+		return signal[byHeight[a]] > signal[byHeight[b]]
+	})
+
+	var peaks []int
+	for _, idx := range byHeight {
+		keep := true
+		for _, p := range peaks {
+			if d := float64(idx - p); d < minDistance && -d < minDistance {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			peaks = append(peaks, idx)
+		}
+	}
+
+	sort.Ints(peaks)
+	return peaks
+}
@@ -0,0 +1,65 @@
+package analysis
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// gaussianPulses возвращает сумму гауссовых импульсов на нулевом фоне
+func gaussianPulses(n int, centers []int, heights []float64, width float64) []float64 {
+	signal := make([]float64, n)
+	for i := range signal {
+		for k, c := range centers {
+			d := float64(i-c) / width
+			signal[i] += heights[k] * math.Exp(-d*d/2)
+		}
+	}
+	return signal
+}
+
+// TestFindPeaks_SeparatedPeaks проверяет поиск трех хорошо разделенных пиков
+func TestFindPeaks_SeparatedPeaks(t *testing.T) {
+	centers := []int{50, 150, 260}
+	signal := gaussianPulses(300, centers, []float64{1, 2, 1.5}, 5)
+
+	peaks := FindPeaks(signal, 0.5, 20)
+	if !reflect.DeepEqual(peaks, centers) {
+		t.Errorf("Ожидались пики %v, получено %v", centers, peaks)
+	}
+
+	// Порог выше самого низкого пика отсекает его
+	peaks = FindPeaks(signal, 1.2, 20)
+	if !reflect.DeepEqual(peaks, []int{150, 260}) {
+		t.Errorf("С порогом 1.2 ожидались пики [150 260], получено %v", peaks)
+	}
+}
+
+// TestFindPeaks_ClosePeaksCollapse проверяет, что из двух близких пиков
+// остается более высокий
+func TestFindPeaks_ClosePeaksCollapse(t *testing.T) {
+	signal := gaussianPulses(200, []int{90, 100}, []float64{0.8, 1}, 2)
+
+	if peaks := FindPeaks(signal, 0.1, 0); len(peaks) != 2 {
+		t.Fatalf("Без ограничения расстояния ожидалось 2 пика, получено %v", peaks)
+	}
+
+	peaks := FindPeaks(signal, 0.1, 15)
+	if !reflect.DeepEqual(peaks, []int{100}) {
+		t.Errorf("Ожидался пик [100], получено %v", peaks)
+	}
+}
+
+// TestFindPeaks_Plateau проверяет обработку плоской вершины и краев
+func TestFindPeaks_Plateau(t *testing.T) {
+	signal := []float64{5, 1, 3, 3, 3, 1, 2, 2, 4}
+
+	peaks := FindPeaks(signal, 0, 0)
+	if !reflect.DeepEqual(peaks, []int{2}) {
+		t.Errorf("Ожидался пик [2], получено %v", peaks)
+	}
+
+	if peaks := FindPeaks(nil, 0, 0); len(peaks) != 0 {
+		t.Errorf("Для пустого сигнала ожидалось отсутствие пиков, получено %v", peaks)
+	}
+}
@@ -0,0 +1,52 @@
+package analysis
+
+import "math"
+
+// SignalStats содержит основные статистические характеристики сигнала
+type SignalStats struct {
+	Mean        float64 // Среднее значение (постоянная составляющая)
+	RMS         float64 // Среднеквадратичное значение
+	Variance    float64 // Дисперсия (по всей выборке, деление на N)
+	Min         float64 // Минимальное значение
+	Max         float64 // Максимальное значение
+	PeakToPeak  float64 // Размах Max - Min
+	CrestFactor float64 // Пик-фактор max(|x|) / RMS (0 для нулевого сигнала)
+}
+
+// Stats вычисляет статистики сигнала за один проход.
+// Среднее и дисперсия накапливаются по алгоритму Уэлфорда, что устойчиво
+// к большой постоянной составляющей. Для пустого сигнала возвращаются нули
+func Stats(signal []float64) SignalStats {
+	if len(signal) == 0 {
+		return SignalStats{}
+	}
+
+	stats := SignalStats{
+		Min: signal[0],
+		Max: signal[0],
+	}
+
+	var mean, m2, sumSquares float64
+	for i, x := range signal {
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+		sumSquares += x * x
+
+		stats.Min = math.Min(stats.Min, x)
+		stats.Max = math.Max(stats.Max, x)
+	}
+
+	n := float64(len(signal))
+	stats.Mean = mean
+	stats.Variance = m2 / n
+	stats.RMS = math.Sqrt(sumSquares / n)
+	stats.PeakToPeak = stats.Max - stats.Min
+
+	if stats.RMS > 0 {
+		peak := math.Max(math.Abs(stats.Min), math.Abs(stats.Max))
+		stats.CrestFactor = peak / stats.RMS
+	}
+
+	return stats
+}
@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+)
+
+// TestStats_Constant проверяет статистики постоянного сигнала
+func TestStats_Constant(t *testing.T) {
+	signal := make([]float64, 100)
+	for i := range signal {
+		signal[i] = -2.5
+	}
+
+	s := Stats(signal)
+	expected := SignalStats{
+		Mean:        -2.5,
+		RMS:         2.5,
+		Variance:    0,
+		Min:         -2.5,
+		Max:         -2.5,
+		PeakToPeak:  0,
+		CrestFactor: 1,
+	}
+	if s != expected {
+		t.Errorf("Ожидалось %+v, получено %+v", expected, s)
+	}
+}
+
+// TestStats_Sine проверяет статистики синусоиды единичной амплитуды
+func TestStats_Sine(t *testing.T) {
+	// Целое число периодов
+	const n = 1000
+	signal := make([]float64, n)
+	for i := range signal {
+		signal[i] = math.Sin(2*math.Pi*10*float64(i)/n + 0.3)
+	}
+
+	s := Stats(signal)
+	checks := []struct {
+		name          string
+		got, expected float64
+	}{
+		{"Mean", s.Mean, 0},
+		{"RMS", s.RMS, 1 / math.Sqrt2},
+		{"Variance", s.Variance, 0.5},
+		{"CrestFactor", s.CrestFactor, math.Sqrt2},
+		{"PeakToPeak", s.PeakToPeak, 2},
+	}
+	for _, c := range checks {
+		if math.Abs(c.got-c.expected) > 1e-3 {
+			t.Errorf("%s: ожидалось %f, получено %f", c.name, c.expected, c.got)
+		}
+	}
+}
+
+// TestStats_LargeOffset проверяет точность дисперсии при большой постоянной составляющей
+func TestStats_LargeOffset(t *testing.T) {
+	signal := []float64{1e9 + 1, 1e9 - 1, 1e9 + 1, 1e9 - 1}
+
+	s := Stats(signal)
+	if math.Abs(s.Variance-1) > 1e-6 {
+		t.Errorf("Дисперсия: ожидалось 1, получено %f", s.Variance)
+	}
+	if s.Mean != 1e9 {
+		t.Errorf("Среднее: ожидалось 1e9, получено %f", s.Mean)
+	}
+}
+
+// TestStats_Empty проверяет пустой сигнал
+func TestStats_Empty(t *testing.T) {
+	if s := Stats(nil); s != (SignalStats{}) {
+		t.Errorf("Для пустого сигнала ожидались нули, получено %+v", s)
+	}
+}
@@ -0,0 +1,62 @@
+package correlate
+
+// CrossCorrelate вычисляет полную линейную взаимную корреляцию сигналов x и y.
+// Длина результата равна len(x)+len(y)-1; элемент с индексом k соответствует
+// сдвигу lag = k - (len(x)-1):
+//
+//	r[lag] = sum(x[n] * y[n+lag])
+//
+// Если y - это x, задержанный на d отсчетов, максимум достигается при lag = d
+func CrossCorrelate(x, y []float64) []float64 {
+	if len(x) == 0 || len(y) == 0 {
+		return []float64{}
+	}
+
+	result := make([]float64, len(x)+len(y)-1)
+	offset := len(x) - 1
+
+	for k := range result {
+		lag := k - offset
+
+		// Диапазон n, для которого 0 <= n < len(x) и 0 <= n+lag < len(y)
+		start := 0
+		if lag < 0 {
+			start = -lag
+		}
+		end := len(x)
+		if len(y)-lag < end {
+			end = len(y) - lag
+		}
+
+		var sum float64
+		for n := start; n < end; n++ {
+			sum += x[n] * y[n+lag]
+		}
+		result[k] = sum
+	}
+
+	return result
+}
+
+// AutoCorrelate вычисляет полную автокорреляцию сигнала x.
+// Длина результата равна 2*len(x)-1, нулевой сдвиг соответствует индексу len(x)-1
+func AutoCorrelate(x []float64) []float64 {
+	return CrossCorrelate(x, x)
+}
+
+// EstimateDelay оценивает задержку y относительно x в отсчетах
+// по положению максимума взаимной корреляции
+func EstimateDelay(x, y []float64) int {
+	r := CrossCorrelate(x, y)
+	if len(r) == 0 {
+		return 0
+	}
+
+	best := 0
+	for k, v := range r {
+		if v > r[best] {
+			best = k
+		}
+	}
+	return best - (len(x) - 1)
+}
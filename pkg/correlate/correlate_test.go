@@ -0,0 +1,98 @@
+package correlate
+
+import (
+	"math"
+	"testing"
+)
+
+// TestCrossCorrelateDelay проверяет положение максимума для задержанной копии сигнала
+func TestCrossCorrelateDelay(t *testing.T) {
+	n := 200
+	x := make([]float64, n)
+	for i := range x {
+		// Синусоида с огибающей, чтобы максимум корреляции был единственным
+		x[i] = math.Sin(2*math.Pi*0.05*float64(i)) * math.Exp(-math.Pow(float64(i-60)/20, 2))
+	}
+
+	for _, delay := range []int{0, 1, 7, 35} {
+		y := make([]float64, n)
+		copy(y[delay:], x[:n-delay])
+
+		r := CrossCorrelate(x, y)
+		if len(r) != len(x)+len(y)-1 {
+			t.Fatalf("Длина: ожидалось %d, получено %d", len(x)+len(y)-1, len(r))
+		}
+
+		peak := 0
+		for k, v := range r {
+			if v > r[peak] {
+				peak = k
+			}
+		}
+		if lag := peak - (len(x) - 1); lag != delay {
+			t.Errorf("Задержка %d: максимум при сдвиге %d", delay, lag)
+		}
+		if got := EstimateDelay(x, y); got != delay {
+			t.Errorf("EstimateDelay: ожидалось %d, получено %d", delay, got)
+		}
+	}
+}
+
+// TestCrossCorrelateValues проверяет значения корреляции на коротких последовательностях
+func TestCrossCorrelateValues(t *testing.T) {
+	x := []float64{1, 2, 3}
+	y := []float64{0, 1, 0.5}
+
+	// r[lag] = sum(x[n] * y[n+lag]), lag = -2..2
+	expected := []float64{
+		3 * 0,             // lag -2: x[2]*y[0]
+		2*0 + 3*1,         // lag -1: x[1]*y[0] + x[2]*y[1]
+		1*0 + 2*1 + 3*0.5, // lag 0
+		1*1 + 2*0.5,       // lag 1
+		1 * 0.5,           // lag 2
+	}
+
+	r := CrossCorrelate(x, y)
+	for i := range expected {
+		if math.Abs(r[i]-expected[i]) > 1e-12 {
+			t.Errorf("r[%d]: ожидалось %f, получено %f", i, expected[i], r[i])
+		}
+	}
+
+	// Разные длины
+	if got := CrossCorrelate([]float64{1}, []float64{1, 2, 3}); len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("Корреляция с одним отсчетом: получено %v", got)
+	}
+
+	if got := CrossCorrelate(nil, y); got == nil || len(got) != 0 {
+		t.Errorf("Пустой вход: ожидался пустой срез, получено %v", got)
+	}
+}
+
+// TestAutoCorrelate проверяет симметрию и максимум автокорреляции
+func TestAutoCorrelate(t *testing.T) {
+	x := []float64{0.5, -1, 2, 0.25, -0.75}
+	r := AutoCorrelate(x)
+
+	if len(r) != 2*len(x)-1 {
+		t.Fatalf("Длина: ожидалось %d, получено %d", 2*len(x)-1, len(r))
+	}
+
+	var energy float64
+	for _, v := range x {
+		energy += v * v
+	}
+	center := len(x) - 1
+	if math.Abs(r[center]-energy) > 1e-12 {
+		t.Errorf("Нулевой сдвиг: ожидалось %f, получено %f", energy, r[center])
+	}
+
+	for k := 1; k < len(x); k++ {
+		if math.Abs(r[center-k]-r[center+k]) > 1e-12 {
+			t.Errorf("Нарушена симметрия при сдвиге %d", k)
+		}
+		if math.Abs(r[center+k]) > r[center] {
+			t.Errorf("Сдвиг %d превышает значение при нулевом сдвиге", k)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Modulation определяет тип фазовой манипуляции для петли Костаса
+type Modulation int
+
+const (
+	BPSK Modulation = iota // Двоичная фазовая манипуляция
+	QPSK                   // Квадратурная фазовая манипуляция
+)
+
+// String возвращает строковое представление типа манипуляции
+func (m Modulation) String() string {
+	switch m {
+	case BPSK:
+		return "BPSK"
+	case QPSK:
+		return "QPSK"
+	default:
+		return "Unknown"
+	}
+}
+
+// CostasLoop восстанавливает подавленную несущую сигналов BPSK/QPSK.
+// Вход поворачивается NCO, ошибка фазы вычисляется по синфазной и
+// квадратурной составляющим (BPSK: I·Q; QPSK: sign(I)·Q - sign(Q)·I),
+// не зависящим от модуляции, и подается на ПИ-фильтр петли. Петля захватывает
+// несущую с неоднозначностью фазы 180° (BPSK) или 90° (QPSK), которую
+// необходимо разрешать на уровне данных (например, дифференциальным кодированием)
+type CostasLoop struct {
+	modulation Modulation // Тип манипуляции
+	phase      float64    // Фаза NCO в радианах
+	frequency  float64    // Частота NCO в радианах на отсчет
+	kp         float64    // Пропорциональный коэффициент петлевого фильтра
+	ki         float64    // Интегральный коэффициент петлевого фильтра
+	lastError  float64    // Ошибка фазы на последнем отсчете
+}
+
+// NewCostasLoop создает петлю Костаса с шумовой полосой loopBandwidth,
+// нормированной к символьной частоте (0 < loopBandwidth < 0.5).
+// Ожидается один отсчет на символ с амплитудой порядка единицы
+func NewCostasLoop(modulation Modulation, loopBandwidth float64) *CostasLoop {
+	if modulation != BPSK && modulation != QPSK {
+		panic("CostasLoop: unknown modulation")
+	}
+	if loopBandwidth <= 0 || loopBandwidth >= 0.5 {
+		panic("CostasLoop: loop bandwidth must be between 0 and 0.5")
+	}
+
+	kp, ki := loopFilterGains(loopBandwidth)
+	return &CostasLoop{
+		modulation: modulation,
+		kp:         kp,
+		ki:         ki,
+	}
+}
+
+// Process обрабатывает один отсчет и возвращает символ после компенсации несущей
+func (cl *CostasLoop) Process(sample complex128) (symbol complex128) {
+	symbol = sample * cmplx.Rect(1, -cl.phase)
+	i, q := real(symbol), imag(symbol)
+
+	var phaseError float64
+	switch cl.modulation {
+	case BPSK:
+		phaseError = i * q
+	case QPSK:
+		phaseError = sign(i)*q - sign(q)*i
+	}
+	cl.lastError = phaseError
+
+	cl.frequency += cl.ki * phaseError
+	cl.phase = normalizePhase(cl.phase + cl.frequency + cl.kp*phaseError)
+
+	return symbol
+}
+
+// GetPhaseError возвращает ошибку фазы на последнем отсчете
+func (cl *CostasLoop) GetPhaseError() float64 {
+	return cl.lastError
+}
+
+// GetPhase возвращает текущую фазу NCO в радианах
+func (cl *CostasLoop) GetPhase() float64 {
+	return cl.phase
+}
+
+// GetFrequency возвращает текущую частоту NCO в циклах на отсчет
+func (cl *CostasLoop) GetFrequency() float64 {
+	return cl.frequency / (2 * math.Pi)
+}
+
+// sign возвращает знак числа (+1 для нуля)
+func sign(x float64) float64 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
@@ -0,0 +1,102 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestCostasLoop_BPSK(t *testing.T) {
+	const n = 3000
+	rng := rand.New(rand.NewSource(1))
+	channel := cmplx.Rect(1, 30*math.Pi/180)
+
+	loop := NewCostasLoop(BPSK, 0.02)
+
+	bitErrors := 0
+	for k := 0; k < n; k++ {
+		bit := rng.Intn(2)
+		symbol := complex(float64(2*bit-1), 0)
+
+		out := loop.Process(symbol * channel)
+		if k < 500 {
+			continue // Захват несущей
+		}
+
+		decided := 0
+		if real(out) > 0 {
+			decided = 1
+		}
+		if decided != bit {
+			bitErrors++
+		}
+		if math.Abs(imag(out)) > 1e-3 {
+			t.Fatalf("Символ %d: квадратурная составляющая %v после захвата", k, imag(out))
+		}
+	}
+
+	if bitErrors != 0 {
+		t.Errorf("Ошибочных бит: %d из %d", bitErrors, n-500)
+	}
+	if phase := loop.GetPhase(); math.Abs(phase-math.Pi/6) > 1e-3 {
+		t.Errorf("Фаза NCO = %v, ожидается π/6", phase)
+	}
+}
+
+func TestCostasLoop_PhaseAmbiguity(t *testing.T) {
+	// Фаза канала 150°: петля захватывается на -30° (ближайшая точка с
+	// неоднозначностью 180°), биты восстанавливаются с инверсией
+	rng := rand.New(rand.NewSource(2))
+	channel := cmplx.Rect(1, 150*math.Pi/180)
+	loop := NewCostasLoop(BPSK, 0.02)
+
+	var agree, disagree int
+	for k := 0; k < 2000; k++ {
+		bit := float64(2*rng.Intn(2) - 1)
+		out := loop.Process(complex(bit, 0) * channel)
+		if k < 500 {
+			continue
+		}
+		if real(out)*bit > 0 {
+			agree++
+		} else {
+			disagree++
+		}
+	}
+
+	if agree != 0 && disagree != 0 {
+		t.Errorf("Ожидалось постоянное соответствие или инверсия: совпало %d, инвертировано %d", agree, disagree)
+	}
+	if math.Abs(loop.GetPhaseError()) > 1e-3 {
+		t.Errorf("Остаточная ошибка фазы = %v", loop.GetPhaseError())
+	}
+}
+
+func TestCostasLoop_QPSKWithFrequencyOffset(t *testing.T) {
+	const (
+		n      = 5000
+		offset = 0.002 // Циклы на символ
+	)
+	rng := rand.New(rand.NewSource(3))
+	loop := NewCostasLoop(QPSK, 0.02)
+
+	for k := 0; k < n; k++ {
+		symbol := complex(float64(2*rng.Intn(2)-1), float64(2*rng.Intn(2)-1)) / math.Sqrt2
+		rotation := cmplx.Rect(1, 2*math.Pi*offset*float64(k)+0.3)
+
+		out := loop.Process(symbol * rotation)
+		if k < 1000 {
+			continue
+		}
+
+		// После захвата символы лежат на осях созвездия QPSK с точностью до поворота на 90°
+		if math.Abs(math.Abs(real(out))-math.Abs(imag(out))) > 0.01 {
+			t.Fatalf("Символ %d: %v не является точкой созвездия QPSK", k, out)
+		}
+	}
+
+	if math.Abs(loop.GetFrequency()-offset) > 1e-5 {
+		t.Errorf("Частота NCO = %v, ожидается %v", loop.GetFrequency(), offset)
+	}
+}
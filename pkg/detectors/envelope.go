@@ -0,0 +1,64 @@
+package detectors
+
+import "math"
+
+// EnvelopeDetector выделяет огибающую сигнала (амплитудная демодуляция):
+// входной сигнал выпрямляется, затем сглаживается ФНЧ 1-го порядка в
+// экспоненциальной форме y[n] = alpha*x[n] + (1-alpha)*y[n-1], как в
+// filters.NewFirstOrderLowPassExp. Коэффициент alpha выбирается по постоянной
+// времени атаки, когда выпрямленный сигнал превышает огибающую, и по постоянной
+// времени спада в противном случае
+type EnvelopeDetector struct {
+	attackAlpha  float64 // Коэффициент сглаживания при нарастании
+	releaseAlpha float64 // Коэффициент сглаживания при спаде
+	envelope     float64 // Текущее значение огибающей
+}
+
+// NewEnvelopeDetector создает детектор огибающей.
+// attackTime и releaseTime - постоянные времени нарастания и спада в секундах
+// (0 означает мгновенную реакцию), sampleRate - частота дискретизации в Гц
+func NewEnvelopeDetector(attackTime, releaseTime, sampleRate float64) *EnvelopeDetector {
+	if sampleRate <= 0 {
+		panic("EnvelopeDetector: sample rate must be positive")
+	}
+	if attackTime < 0 || releaseTime < 0 {
+		panic("EnvelopeDetector: time constants cannot be negative")
+	}
+
+	return &EnvelopeDetector{
+		attackAlpha:  smoothingAlpha(attackTime, sampleRate),
+		releaseAlpha: smoothingAlpha(releaseTime, sampleRate),
+	}
+}
+
+// smoothingAlpha вычисляет коэффициент экспоненциального сглаживания
+// для постоянной времени tau: alpha = 1 - exp(-1/(tau*fs))
+func smoothingAlpha(tau, sampleRate float64) float64 {
+	if tau == 0 {
+		return 1
+	}
+	return 1 - math.Exp(-1/(tau*sampleRate))
+}
+
+// Detect обрабатывает один отсчет и возвращает текущее значение огибающей
+func (ed *EnvelopeDetector) Detect(sample float64) float64 {
+	rectified := math.Abs(sample)
+
+	alpha := ed.releaseAlpha
+	if rectified > ed.envelope {
+		alpha = ed.attackAlpha
+	}
+	ed.envelope = alpha*rectified + (1-alpha)*ed.envelope
+
+	return ed.envelope
+}
+
+// Envelope возвращает текущее значение огибающей
+func (ed *EnvelopeDetector) Envelope() float64 {
+	return ed.envelope
+}
+
+// Reset сбрасывает состояние детектора
+func (ed *EnvelopeDetector) Reset() {
+	ed.envelope = 0
+}
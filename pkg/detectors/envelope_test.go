@@ -0,0 +1,74 @@
+package detectors
+
+import (
+	"math"
+	"testing"
+)
+
+// TestEnvelopeDetector_AM проверяет выделение огибающей 50 Гц
+// у несущей 1 кГц с амплитудной модуляцией
+func TestEnvelopeDetector_AM(t *testing.T) {
+	const (
+		fs          = 48000.0
+		carrierFreq = 1000.0
+		modFreq     = 50.0
+		depth       = 0.5
+	)
+
+	detector := NewEnvelopeDetector(0.00002, 0.003, fs)
+
+	var maxDeviation float64
+	for i := 0; i < int(fs/5); i++ {
+		tm := float64(i) / fs
+		envelope := 1 + depth*math.Sin(2*math.Pi*modFreq*tm)
+		output := detector.Detect(envelope * math.Sin(2*math.Pi*carrierFreq*tm))
+
+		// Пропускаем установление (один период модуляции)
+		if tm < 1/modFreq {
+			continue
+		}
+		// Отклонение относительно амплитуды несущей (пульсации на частоте 2 кГц)
+		deviation := math.Abs(output - envelope)
+		maxDeviation = math.Max(maxDeviation, deviation)
+	}
+
+	if maxDeviation > 0.15 {
+		t.Errorf("Огибающая отклоняется от модулирующего сигнала на %.3f", maxDeviation)
+	}
+}
+
+// TestEnvelopeDetector_AttackRelease проверяет реакцию на скачок амплитуды
+func TestEnvelopeDetector_AttackRelease(t *testing.T) {
+	const fs = 1000.0
+	detector := NewEnvelopeDetector(0, 0.1, fs)
+
+	// Мгновенная атака
+	if got := detector.Detect(-2); got != 2 {
+		t.Errorf("Атака: ожидалось 2, получено %f", got)
+	}
+
+	// Спад за одну постоянную времени до уровня 1/e
+	var got float64
+	for i := 0; i < int(0.1*fs); i++ {
+		got = detector.Detect(0)
+	}
+	if expected := 2 / math.E; math.Abs(got-expected) > 0.01 {
+		t.Errorf("Спад: ожидалось %f, получено %f", expected, got)
+	}
+
+	detector.Reset()
+	if detector.Envelope() != 0 {
+		t.Errorf("После Reset ожидалось 0, получено %f", detector.Envelope())
+	}
+}
+
+// TestEnvelopeDetector_InvalidParameters проверяет панику при некорректных параметрах
+func TestEnvelopeDetector_InvalidParameters(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ожидалась паника при отрицательной постоянной времени")
+		}
+	}()
+
+	_ = NewEnvelopeDetector(-1, 0.1, 1000)
+}
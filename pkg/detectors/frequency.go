@@ -0,0 +1,67 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// FrequencyDetector оценивает постоянную расстройку частоты между входным
+// сигналом и опорным сигналом. Разность фаз последовательных отсчетов
+// приводится к диапазону (-π, π] функцией normalizePhase, поэтому переход
+// фазы через ±π не искажает оценку. Однозначно оцениваются расстройки
+// в пределах ±fs/2
+type FrequencyDetector struct {
+	referenceSignal complex128 // Опорный сигнал (нормированный)
+	prevPhase       float64    // Разность фаз на предыдущем отсчете
+	hasPrev         bool       // Получен ли хотя бы один отсчет
+	sumDelta        float64    // Сумма приращений фазы
+	count           int        // Количество приращений
+}
+
+// NewFrequencyDetector создает новый частотный детектор
+func NewFrequencyDetector(referenceSignal complex128) *FrequencyDetector {
+	return &FrequencyDetector{
+		referenceSignal: referenceSignal / complex(cmplx.Abs(referenceSignal), 0),
+	}
+}
+
+// Process обрабатывает один комплексный отсчет и возвращает приращение
+// фазы относительно предыдущего отсчета в радианах на отсчет
+func (fd *FrequencyDetector) Process(inputSignal complex128) float64 {
+	phase := cmplx.Phase(inputSignal) - cmplx.Phase(fd.referenceSignal)
+
+	if !fd.hasPrev {
+		fd.prevPhase = phase
+		fd.hasPrev = true
+		return 0
+	}
+
+	delta := normalizePhase(phase - fd.prevPhase)
+	fd.prevPhase = phase
+	fd.sumDelta += delta
+	fd.count++
+
+	return delta
+}
+
+// EstimateRadians возвращает среднее приращение фазы в радианах на отсчет
+func (fd *FrequencyDetector) EstimateRadians() float64 {
+	if fd.count == 0 {
+		return 0
+	}
+	return fd.sumDelta / float64(fd.count)
+}
+
+// EstimateHz возвращает оценку расстройки частоты в герцах
+// для заданной частоты дискретизации
+func (fd *FrequencyDetector) EstimateHz(samplingRate float64) float64 {
+	return fd.EstimateRadians() * samplingRate / (2 * math.Pi)
+}
+
+// Reset сбрасывает накопленную оценку
+func (fd *FrequencyDetector) Reset() {
+	fd.prevPhase = 0
+	fd.hasPrev = false
+	fd.sumDelta = 0
+	fd.count = 0
+}
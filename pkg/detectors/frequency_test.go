@@ -0,0 +1,56 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestFrequencyDetector_EstimateHz(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset float64
+		fs     float64
+	}{
+		{"положительная расстройка", 10, 8000},
+		{"отрицательная расстройка", -10, 8000},
+		{"переход через π, положительная", 300, 1000},
+		{"переход через π, отрицательная", -450, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference := cmplx.Rect(2, 0.4)
+			fd := NewFrequencyDetector(reference)
+
+			for i := 0; i < 2000; i++ {
+				phase := 0.4 + 1.1 + 2*math.Pi*tt.offset*float64(i)/tt.fs
+				fd.Process(cmplx.Rect(0.5, phase))
+			}
+
+			if got := fd.EstimateHz(tt.fs); math.Abs(got-tt.offset) > 1e-6 {
+				t.Errorf("EstimateHz() = %v, ожидается %v", got, tt.offset)
+			}
+		})
+	}
+}
+
+func TestFrequencyDetector_Reset(t *testing.T) {
+	fd := NewFrequencyDetector(complex(1, 0))
+
+	if fd.EstimateHz(1000) != 0 {
+		t.Errorf("Оценка без отсчетов = %v, ожидается 0", fd.EstimateHz(1000))
+	}
+
+	fd.Process(complex(1, 0))
+	fd.Process(complex(0, 1))
+	if got := fd.EstimateRadians(); math.Abs(got-math.Pi/2) > 1e-12 {
+		t.Errorf("EstimateRadians() = %v, ожидается π/2", got)
+	}
+
+	fd.Reset()
+	fd.Process(complex(0, 1))
+	if fd.EstimateRadians() != 0 {
+		t.Errorf("После Reset оценка = %v, ожидается 0", fd.EstimateRadians())
+	}
+}
@@ -0,0 +1,77 @@
+package detectors
+
+import "dsp_go/pkg/filters"
+
+// MatchedFilter - согласованный фильтр для обнаружения известной формы импульса.
+// Это КИХ-фильтр с обращенным во времени шаблоном, т.е. корреляция входного
+// сигнала с шаблоном. Выход причинный: максимум отклика на шаблон, начинающийся
+// в отсчете d, приходится на отсчет d + len(template) - 1 (см. GetDelay)
+type MatchedFilter struct {
+	fir         *filters.FIRFilter // КИХ-фильтр с обращенным шаблоном
+	templateLen int                // Длина шаблона
+	energy      float64            // Энергия шаблона (значение пика без шума)
+	output      []float64          // Выход последнего вызова Process
+}
+
+// NewMatchedFilter создает согласованный фильтр для заданного шаблона
+func NewMatchedFilter(template []float64) *MatchedFilter {
+	if len(template) == 0 {
+		panic("MatchedFilter: template cannot be empty")
+	}
+
+	reversed := make([]float64, len(template))
+	var energy float64
+	for i, v := range template {
+		reversed[len(template)-1-i] = v
+		energy += v * v
+	}
+
+	return &MatchedFilter{
+		fir:         filters.NewFIRFilter(reversed),
+		templateLen: len(template),
+		energy:      energy,
+	}
+}
+
+// Process вычисляет выход согласованного фильтра для блока отсчетов.
+// Состояние сохраняется между вызовами, поэтому поток можно подавать блоками
+func (mf *MatchedFilter) Process(input []float64) []float64 {
+	mf.output = mf.fir.Process(input)
+	return mf.output
+}
+
+// DetectPeaks возвращает индексы отсчетов выхода последнего вызова Process,
+// в которых выход превышает порог и является локальным максимумом.
+// Так каждому вхождению шаблона соответствует один индекс
+func (mf *MatchedFilter) DetectPeaks(threshold float64) []int {
+	var peaks []int
+	for i, v := range mf.output {
+		if v <= threshold {
+			continue
+		}
+		if i > 0 && mf.output[i-1] >= v {
+			continue
+		}
+		if i < len(mf.output)-1 && mf.output[i+1] > v {
+			continue
+		}
+		peaks = append(peaks, i)
+	}
+	return peaks
+}
+
+// Reset сбрасывает состояние фильтра и сохраненный выход
+func (mf *MatchedFilter) Reset() {
+	mf.fir.Reset()
+	mf.output = nil
+}
+
+// GetDelay возвращает задержку пика относительно начала шаблона во входном сигнале
+func (mf *MatchedFilter) GetDelay() int {
+	return mf.templateLen - 1
+}
+
+// GetEnergy возвращает энергию шаблона - значение пика при точном совпадении без шума
+func (mf *MatchedFilter) GetEnergy() float64 {
+	return mf.energy
+}
@@ -0,0 +1,80 @@
+package detectors
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// chirpTemplate возвращает короткий ЛЧМ-импульс
+func chirpTemplate(n int) []float64 {
+	template := make([]float64, n)
+	for i := range template {
+		t := float64(i) / float64(n)
+		template[i] = math.Sin(2 * math.Pi * (2*t + 6*t*t))
+	}
+	return template
+}
+
+// TestMatchedFilter_FindsTemplateInNoise проверяет обнаружение шаблона в шуме
+func TestMatchedFilter_FindsTemplateInNoise(t *testing.T) {
+	template := chirpTemplate(64)
+	rng := rand.New(rand.NewSource(1))
+
+	const offset = 700
+	record := make([]float64, 2000)
+	for i := range record {
+		record[i] = 0.3 * rng.NormFloat64()
+	}
+	for i, v := range template {
+		record[offset+i] += v
+	}
+
+	mf := NewMatchedFilter(template)
+	output := mf.Process(record)
+	if len(output) != len(record) {
+		t.Fatalf("Длина выхода: ожидалось %d, получено %d", len(record), len(output))
+	}
+
+	peaks := mf.DetectPeaks(0.6 * mf.GetEnergy())
+	if len(peaks) != 1 {
+		t.Fatalf("Ожидался 1 пик, получено %d: %v", len(peaks), peaks)
+	}
+	if want := offset + mf.GetDelay(); peaks[0] != want {
+		t.Errorf("Положение пика: ожидалось %d, получено %d", want, peaks[0])
+	}
+}
+
+// TestMatchedFilter_TwoOccurrences проверяет обнаружение нескольких вхождений
+// при подаче сигнала блоками
+func TestMatchedFilter_TwoOccurrences(t *testing.T) {
+	template := chirpTemplate(32)
+	record := make([]float64, 400)
+	offsets := []int{50, 250}
+	for _, off := range offsets {
+		copy(record[off:], template)
+	}
+
+	mf := NewMatchedFilter(template)
+	first := mf.Process(record[:200])
+	second := mf.Process(record[200:])
+
+	whole := NewMatchedFilter(template).Process(record)
+	joined := append(append([]float64{}, first...), second...)
+	for i := range whole {
+		if math.Abs(joined[i]-whole[i]) > 1e-12 {
+			t.Fatalf("Отсчет %d: блочная обработка %f, целиком %f", i, joined[i], whole[i])
+		}
+	}
+
+	// Пик второго вхождения попадает во второй блок
+	peaks := mf.DetectPeaks(0.9 * mf.GetEnergy())
+	if len(peaks) != 1 || peaks[0]+200 != offsets[1]+mf.GetDelay() {
+		t.Errorf("Пики второго блока: получено %v", peaks)
+	}
+
+	mf.Reset()
+	if peaks := mf.DetectPeaks(0); len(peaks) != 0 {
+		t.Errorf("После Reset ожидалось отсутствие пиков, получено %v", peaks)
+	}
+}
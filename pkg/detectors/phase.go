@@ -3,6 +3,8 @@ package detectors
 import (
 	"math"
 	"math/cmplx"
+
+	"dsp_go/pkg/phaseutil"
 )
 
 // CoherentPhaseDetector представляет собой структуру фазового детектора
@@ -11,13 +13,29 @@ type CoherentPhaseDetector struct {
 	phaseOffset     float64    // Компенсационное смещение фазы
 	alpha           float64    // Коэффициент фильтрации (0 < alpha <= 1)
 	filteredError   float64    // Отфильтрованная ошибка фазы
+
+	// История последних измерений для оценки захвата и ОСШ
+	errorHistory []float64    // Нефильтрованные ошибки фазы
+	mixHistory   []complex128 // Отсчеты x·conj(ref) с опорой на момент измерения
+	historyPos   int          // Позиция записи в кольцевых буферах
+	historyCount int          // Количество отсчетов в истории
 }
 
+const (
+	// historyLength - длина скользящего окна для IsLocked и EstimateSNR
+	historyLength = 64
+
+	// minMagnitude - модуль, ниже которого отсчет считается пропаданием сигнала
+	minMagnitude = 1e-12
+)
+
 // NewCoherentPhaseDetector создает новый экземпляр фазового детектора
 func NewCoherentPhaseDetector(referenceSignal complex128, alpha float64) *CoherentPhaseDetector {
-	// Нормируем опорный сигнал
-	refMagnitude := cmplx.Abs(referenceSignal)
-	refNorm := referenceSignal / complex(refMagnitude, 0)
+	// Нормируем опорный сигнал (нулевой опорный сигнал заменяется на 1)
+	refNorm := complex(1, 0)
+	if refMagnitude := cmplx.Abs(referenceSignal); refMagnitude >= minMagnitude {
+		refNorm = referenceSignal / complex(refMagnitude, 0)
+	}
 
 	if alpha <= 0 || alpha > 1 {
 		alpha = 0.1 // значение по умолчанию
@@ -28,13 +46,20 @@ func NewCoherentPhaseDetector(referenceSignal complex128, alpha float64) *Cohere
 		phaseOffset:     0,
 		alpha:           alpha,
 		filteredError:   0,
+		errorHistory:    make([]float64, historyLength),
+		mixHistory:      make([]complex128, historyLength),
 	}
 }
 
-// Detect измеряет и фильтрует ошибку фазы
+// Detect измеряет и фильтрует ошибку фазы.
+// Отсчет с нулевым модулем (пропадание сигнала) не содержит информации о фазе:
+// состояние детектора не изменяется, возвращается предыдущая ошибка
 func (cpd *CoherentPhaseDetector) Detect(inputSignal complex128) float64 {
 	// Нормируем входной сигнал
 	inputMagnitude := cmplx.Abs(inputSignal)
+	if inputMagnitude < minMagnitude {
+		return normalizePhase(cpd.filteredError - cpd.phaseOffset)
+	}
 	inputNorm := inputSignal / complex(inputMagnitude, 0)
 
 	// Вычисляем разность фаз
@@ -43,6 +68,14 @@ func (cpd *CoherentPhaseDetector) Detect(inputSignal complex128) float64 {
 	// Нормализуем разность фаз в диапазон [-π, π]
 	phaseDiff = normalizePhase(phaseDiff)
 
+	// Сохраняем измерение в истории
+	cpd.errorHistory[cpd.historyPos] = phaseDiff
+	cpd.mixHistory[cpd.historyPos] = inputSignal * cmplx.Conj(cpd.referenceSignal)
+	cpd.historyPos = (cpd.historyPos + 1) % historyLength
+	if cpd.historyCount < historyLength {
+		cpd.historyCount++
+	}
+
 	// Применяем фильтр низких частот (петлевой фильтр)
 	cpd.filteredError = cpd.alpha*phaseDiff + (1-cpd.alpha)*cpd.filteredError
 
@@ -53,6 +86,17 @@ func (cpd *CoherentPhaseDetector) Detect(inputSignal complex128) float64 {
 	return normalizePhase(correctedPhase)
 }
 
+// DetectAll обрабатывает срез входных отсчетов и возвращает ошибку фазы
+// для каждого отсчета. Состояние детектора изменяется так же, как при
+// последовательных вызовах Detect
+func (cpd *CoherentPhaseDetector) DetectAll(inputs []complex128) []float64 {
+	output := make([]float64, len(inputs))
+	for i, input := range inputs {
+		output[i] = cpd.Detect(input)
+	}
+	return output
+}
+
 // UpdateOffset обновляет смещение фазы на основе текущей ошибки
 func (cpd *CoherentPhaseDetector) UpdateOffset() {
 	// Используем отфильтрованную ошибку для коррекции
@@ -75,21 +119,10 @@ func (cpd *CoherentPhaseDetector) SetPhaseOffset(offset float64) {
 //	return phase - math.Pi
 //}
 
-// normalizePhase нормализует фазу в диапазон [-π, π]
+// normalizePhase нормализует фазу в диапазон (-π, π]: -π переходит в π.
+// Соглашение совпадает с math.Atan2 (см. phaseutil.NormalizePhaseUpper)
 func normalizePhase(phase float64) float64 {
-	// Приводим фазу к диапазону [-π, π]
-	phase = math.Mod(phase+math.Pi, 2*math.Pi)
-	if phase < 0 {
-		phase += 2 * math.Pi
-	}
-	// Преобразуем из [0, 2π] в [-π, π]
-	phase -= math.Pi
-
-	// Особый случай: если фаза равна -π, возвращаем π для согласованности
-	if phase == -math.Pi {
-		return math.Pi
-	}
-	return phase
+	return phaseutil.NormalizePhaseUpper(phase)
 }
 
 // GetFilteredError возвращает текущую отфильтрованную ошибку
@@ -102,8 +135,62 @@ func (cpd *CoherentPhaseDetector) GetPhaseOffset() float64 {
 	return cpd.phaseOffset
 }
 
-// UpdateReferenceSignal обновляет опорный сигнал
+// IsLocked проверяет захват по разбросу последних ошибок фазы
+// (UpdateOffset и SetPhaseOffset историю не очищают).
+// Разброс оценивается круговой дисперсией 1 - |mean(e^(jφ))| в диапазоне [0, 1]:
+// 0 для постоянной ошибки, около 1 для равномерно распределенной фазы (шум).
+// Детектор считается захваченным, если дисперсия меньше threshold
+func (cpd *CoherentPhaseDetector) IsLocked(threshold float64) bool {
+	if cpd.historyCount == 0 {
+		return false
+	}
+
+	var sum complex128
+	for _, e := range cpd.errorHistory[:cpd.historyCount] {
+		sum += cmplx.Rect(1, e)
+	}
+	variance := 1 - cmplx.Abs(sum)/float64(cpd.historyCount)
+
+	return variance < threshold
+}
+
+// EstimateSNR оценивает отношение сигнал/шум (в разах) по последним отсчетам
+// как отношение когерентной мощности |mean(x·conj(ref))|^2 к некогерентной
+// mean(|x|^2) - когерентная. Произведение x·conj(ref) запоминается в Detect
+// с опорным сигналом на момент измерения, поэтому UpdateReferenceSignal
+// не искажает оценку по уже накопленным отсчетам. UpdateOffset и
+// SetPhaseOffset историю не очищают (она не зависит от смещения фазы).
+// Для сигнала без шума возвращает +Inf
+func (cpd *CoherentPhaseDetector) EstimateSNR() float64 {
+	if cpd.historyCount == 0 {
+		return 0
+	}
+
+	var coherentSum complex128
+	var totalPower float64
+	for _, m := range cpd.mixHistory[:cpd.historyCount] {
+		coherentSum += m
+		// |x·conj(ref)| = |x|, так как опорный сигнал нормирован
+		totalPower += real(m)*real(m) + imag(m)*imag(m)
+	}
+
+	n := float64(cpd.historyCount)
+	coherentMag := cmplx.Abs(coherentSum) / n
+	coherentPower := coherentMag * coherentMag
+	incoherentPower := totalPower/n - coherentPower
+
+	if incoherentPower <= 1e-12*coherentPower {
+		return math.Inf(1)
+	}
+	return coherentPower / incoherentPower
+}
+
+// UpdateReferenceSignal обновляет опорный сигнал.
+// Опорный сигнал с нулевым модулем игнорируется
 func (cpd *CoherentPhaseDetector) UpdateReferenceSignal(newRef complex128) {
 	magnitude := cmplx.Abs(newRef)
+	if magnitude < minMagnitude {
+		return
+	}
 	cpd.referenceSignal = newRef / complex(magnitude, 0)
 }
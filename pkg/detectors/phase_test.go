@@ -3,6 +3,7 @@ package detectors
 import (
 	"math"
 	"math/cmplx"
+	"math/rand"
 	"testing"
 )
 
@@ -430,3 +431,140 @@ func TestCoherentPhaseDetector_FilteringEffect(t *testing.T) {
 		})
 	}
 }
+
+func TestCoherentPhaseDetector_LockAndSNR(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	// Чистый тон с постоянным сдвигом фазы (в том числе около ±π)
+	for _, offset := range []float64{0.3, math.Pi - 0.01} {
+		cpd := NewCoherentPhaseDetector(complex(1, 0), 0.1)
+		for i := 0; i < 100; i++ {
+			noise := complex(0.01*rng.NormFloat64(), 0.01*rng.NormFloat64())
+			cpd.Detect(cmplx.Rect(1, offset) + noise)
+		}
+
+		if !cpd.IsLocked(0.05) {
+			t.Errorf("Сдвиг %v: тон должен считаться захваченным", offset)
+		}
+		if snr := cpd.EstimateSNR(); snr < 1000 {
+			t.Errorf("Сдвиг %v: ОСШ тона = %v, ожидается > 1000", offset, snr)
+		}
+	}
+
+	// Чистый шум
+	cpd := NewCoherentPhaseDetector(complex(1, 0), 0.1)
+	for i := 0; i < 100; i++ {
+		cpd.Detect(complex(rng.NormFloat64(), rng.NormFloat64()))
+	}
+	if cpd.IsLocked(0.05) {
+		t.Error("Шум не должен считаться захваченным")
+	}
+	if snr := cpd.EstimateSNR(); snr > 0.2 {
+		t.Errorf("ОСШ шума = %v, ожидается < 0.2", snr)
+	}
+
+	// Без измерений детектор не захвачен
+	empty := NewCoherentPhaseDetector(complex(1, 0), 0.1)
+	if empty.IsLocked(1) || empty.EstimateSNR() != 0 {
+		t.Error("Детектор без измерений не должен быть захвачен")
+	}
+}
+
+// TestCoherentPhaseDetector_SNRWithTrackingReference проверяет оценку ОСШ,
+// когда опорный сигнал обновляется на каждом отсчете (как в ФАПЧ)
+func TestCoherentPhaseDetector_SNRWithTrackingReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	cpd := NewCoherentPhaseDetector(complex(1, 0), 0.1)
+
+	// Фаза входа вращается на 0.2 рад/отсчет, опора отслеживает ее со сдвигом 0.1
+	for i := 0; i < 200; i++ {
+		phase := 0.2 * float64(i)
+		cpd.UpdateReferenceSignal(cmplx.Rect(1, phase-0.1))
+		noise := complex(0.01*rng.NormFloat64(), 0.01*rng.NormFloat64())
+		cpd.Detect(cmplx.Rect(1, phase) + noise)
+	}
+
+	if snr := cpd.EstimateSNR(); snr < 1000 {
+		t.Errorf("ОСШ при отслеживающей опоре = %v, ожидается > 1000", snr)
+	}
+	if !cpd.IsLocked(0.05) {
+		t.Error("При отслеживающей опоре детектор должен быть захвачен")
+	}
+
+	// Смещение фазы не очищает историю
+	cpd.SetPhaseOffset(1)
+	cpd.UpdateOffset()
+	if snr := cpd.EstimateSNR(); snr < 1000 {
+		t.Errorf("ОСШ после изменения смещения = %v, ожидается > 1000", snr)
+	}
+}
+
+func TestCoherentPhaseDetector_DetectAll(t *testing.T) {
+	inputs := make([]complex128, 200)
+	for i := range inputs {
+		inputs[i] = cmplx.Rect(1+0.1*math.Sin(float64(i)), 0.02*float64(i))
+	}
+
+	batch := NewCoherentPhaseDetector(complex(1, 1), 0.2)
+	manual := NewCoherentPhaseDetector(complex(1, 1), 0.2)
+
+	got := batch.DetectAll(inputs)
+	if len(got) != len(inputs) {
+		t.Fatalf("DetectAll() вернул %d значений, ожидается %d", len(got), len(inputs))
+	}
+	for i, input := range inputs {
+		if want := manual.Detect(input); got[i] != want {
+			t.Errorf("Отсчет %d: DetectAll() = %v, Detect() = %v", i, got[i], want)
+		}
+	}
+
+	if batch.GetFilteredError() != manual.GetFilteredError() {
+		t.Errorf("filteredError = %v, ожидается %v", batch.GetFilteredError(), manual.GetFilteredError())
+	}
+	if got := batch.DetectAll(nil); len(got) != 0 {
+		t.Errorf("DetectAll(nil) вернул %d значений", len(got))
+	}
+}
+
+func TestCoherentPhaseDetector_ZeroInput(t *testing.T) {
+	cpd := NewCoherentPhaseDetector(complex(1, 0), 0.5)
+	reference := NewCoherentPhaseDetector(complex(1, 0), 0.5)
+
+	inputs := []complex128{cmplx.Rect(1, 0.4), cmplx.Rect(2, 0.5), 0, cmplx.Rect(1, 0.3), cmplx.Rect(0.5, 0.2)}
+	var held float64
+	for i, input := range inputs {
+		got := cpd.Detect(input)
+		if math.IsNaN(got) || math.IsInf(got, 0) {
+			t.Fatalf("Отсчет %d: получено нечисловое значение %v", i, got)
+		}
+
+		if input == 0 {
+			// Пропадание сигнала удерживает предыдущую ошибку
+			if got != held {
+				t.Errorf("Отсчет %d: при нулевом входе %v, ожидается удержание %v", i, got, held)
+			}
+			continue
+		}
+
+		// Последующие отсчеты обрабатываются так, как если бы пропадания не было
+		if want := reference.Detect(input); math.Abs(got-want) > 1e-12 {
+			t.Errorf("Отсчет %d: %v, ожидается %v", i, got, want)
+		}
+		held = got
+	}
+}
+
+func TestCoherentPhaseDetector_ZeroReference(t *testing.T) {
+	cpd := NewCoherentPhaseDetector(0, 1)
+	if ref := cpd.referenceSignal; ref != complex(1, 0) {
+		t.Errorf("Нулевой опорный сигнал заменен на %v, ожидается 1", ref)
+	}
+	if got := cpd.Detect(cmplx.Rect(1, 0.6)); math.Abs(got-0.6) > 1e-12 {
+		t.Errorf("Detect() = %v, ожидается 0.6", got)
+	}
+
+	cpd.UpdateReferenceSignal(0)
+	if ref := cpd.referenceSignal; ref != complex(1, 0) {
+		t.Errorf("UpdateReferenceSignal(0) изменил опорный сигнал на %v", ref)
+	}
+}
@@ -0,0 +1,76 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// PLL представляет собой систему фазовой автоподстройки частоты второго порядка:
+// фазовый детектор (CoherentPhaseDetector) -> пропорционально-интегрирующий
+// петлевой фильтр -> генератор, управляемый числом (NCO). Петля отслеживает
+// частоту и фазу несущей; при постоянной расстройке частоты установившаяся
+// ошибка фазы стремится к нулю
+type PLL struct {
+	detector  *CoherentPhaseDetector // Фазовый детектор (без собственного сглаживания)
+	phase     float64                // Фаза NCO в радианах
+	frequency float64                // Частота NCO в радианах на отсчет
+	kp        float64                // Пропорциональный коэффициент петлевого фильтра
+	ki        float64                // Интегральный коэффициент петлевого фильтра
+}
+
+// NewPLL создает ФАПЧ с начальной частотой NCO centerFreq и шумовой полосой
+// петли loopBandwidth. Обе частоты нормированы к частоте дискретизации
+// (циклы на отсчет, 0 < loopBandwidth < 0.5). Коэффициент демпфирования 1/√2
+func NewPLL(centerFreq, loopBandwidth float64) *PLL {
+	if loopBandwidth <= 0 || loopBandwidth >= 0.5 {
+		panic("PLL: loop bandwidth must be between 0 and 0.5")
+	}
+	if math.Abs(centerFreq) >= 0.5 {
+		panic("PLL: center frequency must be between -0.5 and 0.5")
+	}
+
+	kp, ki := loopFilterGains(loopBandwidth)
+
+	return &PLL{
+		// alpha = 1: сглаживание выполняет петлевой фильтр
+		detector:  NewCoherentPhaseDetector(complex(1, 0), 1),
+		frequency: 2 * math.Pi * centerFreq,
+		kp:        kp,
+		ki:        ki,
+	}
+}
+
+// loopFilterGains рассчитывает коэффициенты ПИ-фильтра петли второго порядка
+// по шумовой полосе Bn (циклы на отсчет) при коэффициенте демпфирования 1/√2
+// и единичных коэффициентах передачи детектора и NCO
+func loopFilterGains(loopBandwidth float64) (kp, ki float64) {
+	const zeta = 1 / math.Sqrt2
+	theta := loopBandwidth / (zeta + 1/(4*zeta))
+	denom := 1 + 2*zeta*theta + theta*theta
+
+	return 4 * zeta * theta / denom, 4 * theta * theta / denom
+}
+
+// Step обрабатывает один комплексный отсчет. Возвращает ошибку фазы между
+// входом и NCO в радианах и отсчет NCO, с которым сравнивался вход
+func (p *PLL) Step(sample complex128) (phaseError float64, nco complex128) {
+	nco = cmplx.Rect(1, p.phase)
+	p.detector.UpdateReferenceSignal(nco)
+	phaseError = p.detector.Detect(sample)
+
+	// Петлевой фильтр: интегратор частоты и пропорциональная поправка фазы
+	p.frequency += p.ki * phaseError
+	p.phase = normalizePhase(p.phase + p.frequency + p.kp*phaseError)
+
+	return phaseError, nco
+}
+
+// Frequency возвращает текущую частоту NCO в циклах на отсчет
+func (p *PLL) Frequency() float64 {
+	return p.frequency / (2 * math.Pi)
+}
+
+// Phase возвращает текущую фазу NCO в радианах
+func (p *PLL) Phase() float64 {
+	return p.phase
+}
@@ -0,0 +1,69 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestPLL_ConstantOffset(t *testing.T) {
+	const carrier = 0.052 // Циклы на отсчет
+	pll := NewPLL(0.05, 0.01)
+
+	var phaseError float64
+	for i := 0; i < 5000; i++ {
+		sample := cmplx.Rect(1, 2*math.Pi*carrier*float64(i)+0.7)
+		phaseError, _ = pll.Step(sample)
+	}
+
+	if math.Abs(phaseError) > 1e-6 {
+		t.Errorf("Остаточная ошибка фазы = %v, ожидается 0", phaseError)
+	}
+	if math.Abs(pll.Frequency()-carrier) > 1e-9 {
+		t.Errorf("Частота NCO = %v, ожидается %v", pll.Frequency(), carrier)
+	}
+}
+
+func TestPLL_TracksDrift(t *testing.T) {
+	const (
+		n         = 40000
+		startFreq = 0.05
+		endFreq   = 0.06
+	)
+	pll := NewPLL(0.048, 0.005)
+
+	var phase float64
+	maxError := 0.0
+	for i := 0; i < n; i++ {
+		freq := startFreq + (endFreq-startFreq)*float64(i)/n
+		phase += 2 * math.Pi * freq
+
+		phaseError, nco := pll.Step(cmplx.Rect(1, phase))
+
+		if i > 5000 {
+			maxError = math.Max(maxError, math.Abs(phaseError))
+			if math.Abs(cmplx.Abs(nco)-1) > 1e-12 {
+				t.Fatalf("Отсчет %d: модуль NCO = %v", i, cmplx.Abs(nco))
+			}
+		}
+	}
+
+	// Петля второго порядка отслеживает линейный дрейф частоты
+	// с постоянной ошибкой фазы (скорость дрейфа / ki ≈ 0.018 рад)
+	if maxError > 0.03 {
+		t.Errorf("Максимальная ошибка фазы после захвата = %v рад", maxError)
+	}
+	if math.Abs(pll.Frequency()-endFreq) > 1e-4 {
+		t.Errorf("Частота NCO = %v, ожидается %v", pll.Frequency(), endFreq)
+	}
+}
+
+func TestPLL_InvalidParameters(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ожидалась паника при нулевой полосе петли")
+		}
+	}()
+
+	_ = NewPLL(0.1, 0)
+}
@@ -0,0 +1,62 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// FrequencyShifter переносит спектр комплексного сигнала на shiftHz герц
+// умножением на e^(j2π·Δf·n/fs). Фаза NCO накапливается между вызовами,
+// поэтому блоки произвольной длины сшиваются без разрывов фазы.
+// Отрицательный сдвиг переносит тон вниз, например на нулевую частоту
+// перед фазовым детектором
+type FrequencyShifter struct {
+	shiftHz    float64 // Сдвиг частоты в герцах
+	sampleRate float64 // Частота дискретизации в герцах
+	increment  float64 // Приращение фазы NCO в радианах на отсчет
+	phase      float64 // Текущая фаза NCO в радианах
+}
+
+// NewFrequencyShifter создает преобразователь частоты со сдвигом shiftHz
+// для частоты дискретизации sampleRate (sampleRate > 0)
+func NewFrequencyShifter(shiftHz, sampleRate float64) *FrequencyShifter {
+	if sampleRate <= 0 {
+		panic("FrequencyShifter: sampling rate must be positive")
+	}
+
+	sh := &FrequencyShifter{sampleRate: sampleRate}
+	sh.SetShift(shiftHz)
+	return sh
+}
+
+// Tick сдвигает частоту одного отсчета
+func (sh *FrequencyShifter) Tick(sample complex128) complex128 {
+	output := sample * cmplx.Rect(1, sh.phase)
+	sh.phase = normalizePhase(sh.phase + sh.increment)
+	return output
+}
+
+// Process сдвигает частоту среза отсчетов
+func (sh *FrequencyShifter) Process(input []complex128) []complex128 {
+	output := make([]complex128, len(input))
+	for i, sample := range input {
+		output[i] = sh.Tick(sample)
+	}
+	return output
+}
+
+// SetShift изменяет сдвиг частоты без разрыва фазы NCO
+func (sh *FrequencyShifter) SetShift(shiftHz float64) {
+	sh.shiftHz = shiftHz
+	sh.increment = 2 * math.Pi * shiftHz / sh.sampleRate
+}
+
+// GetShift возвращает сдвиг частоты в герцах
+func (sh *FrequencyShifter) GetShift() float64 {
+	return sh.shiftHz
+}
+
+// Reset обнуляет фазу NCO
+func (sh *FrequencyShifter) Reset() {
+	sh.phase = 0
+}
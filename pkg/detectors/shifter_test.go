@@ -0,0 +1,76 @@
+package detectors
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// complexTone генерирует комплексную экспоненту частоты freq
+func complexTone(n int, freq, fs, phase float64) []complex128 {
+	tone := make([]complex128, n)
+	for i := range tone {
+		tone[i] = cmplx.Rect(1, phase+2*math.Pi*freq*float64(i)/fs)
+	}
+	return tone
+}
+
+// TestFrequencyShifter_ToBaseband проверяет перенос тона 1 кГц на нулевую частоту
+func TestFrequencyShifter_ToBaseband(t *testing.T) {
+	const fs = 8000.0
+	shifter := NewFrequencyShifter(-1000, fs)
+	input := complexTone(4000, 1000, fs, 0.7)
+
+	// Обработка блоками разной длины не нарушает непрерывность фазы
+	var output []complex128
+	for start := 0; start < len(input); start += 333 {
+		end := min(start+333, len(input))
+		output = append(output, shifter.Process(input[start:end])...)
+	}
+
+	want := cmplx.Rect(1, 0.7)
+	for i, y := range output {
+		if cmplx.Abs(y-want) > 1e-9 {
+			t.Fatalf("Отсчет %d: ожидалось %v, получено %v", i, want, y)
+		}
+	}
+
+	// Частотный детектор подтверждает нулевую частоту
+	fd := NewFrequencyDetector(complex(1, 0))
+	for _, y := range output {
+		fd.Process(y)
+	}
+	if got := fd.EstimateHz(fs); math.Abs(got) > 1e-6 {
+		t.Errorf("Остаточная частота: ожидалось 0 Гц, получено %g Гц", got)
+	}
+}
+
+// TestFrequencyShifter_SetShiftAndReset проверяет смену сдвига и сброс фазы
+func TestFrequencyShifter_SetShiftAndReset(t *testing.T) {
+	const fs = 1000.0
+	shifter := NewFrequencyShifter(50, fs)
+	shifter.Process(make([]complex128, 7))
+
+	shifter.SetShift(-120)
+	if shifter.GetShift() != -120 {
+		t.Errorf("Сдвиг: ожидалось -120, получено %g", shifter.GetShift())
+	}
+
+	shifter.Reset()
+	output := shifter.Process(complexTone(500, 120, fs, 0))
+	for i, y := range output {
+		if cmplx.Abs(y-1) > 1e-9 {
+			t.Fatalf("Отсчет %d после Reset: ожидалось 1, получено %v", i, y)
+		}
+	}
+}
+
+// TestFrequencyShifter_InvalidSampleRate проверяет панику при неверной частоте дискретизации
+func TestFrequencyShifter_InvalidSampleRate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника")
+		}
+	}()
+	NewFrequencyShifter(100, 0)
+}
@@ -0,0 +1,55 @@
+// Package dynamics реализует обработку динамического диапазона сигнала
+package dynamics
+
+import "math"
+
+// Clip жестко ограничивает сигнал диапазоном [-limit, limit].
+// Возвращает новый срез и число отсчетов, вышедших за пределы диапазона
+func Clip(signal []float64, limit float64) (clipped []float64, numClipped int) {
+	checkLimit(limit)
+
+	clipped = make([]float64, len(signal))
+	for i, x := range signal {
+		switch {
+		case x > limit:
+			clipped[i] = limit
+			numClipped++
+		case x < -limit:
+			clipped[i] = -limit
+			numClipped++
+		default:
+			clipped[i] = x
+		}
+	}
+	return clipped, numClipped
+}
+
+// SoftClip плавно ограничивает сигнал: y = limit * tanh(x / limit).
+// Характеристика монотонна и гладка, близка к линейной при |x| << limit
+// и асимптотически стремится к ±limit. Возвращает новый срез и число
+// входных отсчетов, превысивших limit по модулю (которые жесткий
+// ограничитель срезал бы)
+func SoftClip(signal []float64, limit float64) (shaped []float64, numOverLimit int) {
+	checkLimit(limit)
+
+	shaped = make([]float64, len(signal))
+	for i, x := range signal {
+		shaped[i] = SoftClipSample(x, limit)
+		if math.Abs(x) > limit {
+			numOverLimit++
+		}
+	}
+	return shaped, numOverLimit
+}
+
+// SoftClipSample применяет мягкое ограничение к одному отсчету
+func SoftClipSample(x, limit float64) float64 {
+	return limit * math.Tanh(x/limit)
+}
+
+// checkLimit проверяет порог ограничения
+func checkLimit(limit float64) {
+	if limit <= 0 || math.IsInf(limit, 0) || math.IsNaN(limit) {
+		panic("dynamics: limit must be positive and finite")
+	}
+}
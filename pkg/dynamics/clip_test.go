@@ -0,0 +1,90 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+)
+
+// TestClip проверяет жесткое ограничение и подсчет срезанных отсчетов
+func TestClip(t *testing.T) {
+	signal := []float64{0, 0.5, 1.2, -0.9, -3, 0.8, 0.8001}
+
+	clipped, n := Clip(signal, 0.8)
+
+	expected := []float64{0, 0.5, 0.8, -0.8, -0.8, 0.8, 0.8}
+	for i := range expected {
+		if clipped[i] != expected[i] {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], clipped[i])
+		}
+	}
+	if n != 4 {
+		t.Errorf("Срезано отсчетов: ожидалось 4, получено %d", n)
+	}
+
+	// Исходный сигнал не изменяется
+	if signal[2] != 1.2 {
+		t.Error("Clip изменил входной срез")
+	}
+}
+
+// TestSoftClip_MonotonicAndSmooth проверяет монотонность и гладкость
+// характеристики мягкого ограничения, в том числе вблизи порога
+func TestSoftClip_MonotonicAndSmooth(t *testing.T) {
+	const (
+		limit = 1.0
+		step  = 1e-3
+	)
+
+	input := make([]float64, 0, 8001)
+	for x := -4.0; x <= 4.0; x += step {
+		input = append(input, x)
+	}
+
+	shaped, over := SoftClip(input, limit)
+
+	prevSlope := math.NaN()
+	for i := 1; i < len(shaped); i++ {
+		if shaped[i] <= shaped[i-1] {
+			t.Fatalf("Не монотонно при x=%f", input[i])
+		}
+		if math.Abs(shaped[i]) >= limit {
+			t.Fatalf("Выход %f достиг порога при x=%f", shaped[i], input[i])
+		}
+
+		// Наклон меняется плавно (нет изломов)
+		slope := (shaped[i] - shaped[i-1]) / step
+		if !math.IsNaN(prevSlope) && math.Abs(slope-prevSlope) > 1e-2 {
+			t.Fatalf("Излом характеристики при x=%f", input[i])
+		}
+		prevSlope = slope
+	}
+
+	// Около нуля характеристика линейна
+	if got := SoftClipSample(0.01, limit); math.Abs(got-0.01) > 1e-6 {
+		t.Errorf("Малый сигнал: ожидалось 0.01, получено %f", got)
+	}
+
+	expectedOver := 0
+	for _, x := range input {
+		if math.Abs(x) > limit {
+			expectedOver++
+		}
+	}
+	if over != expectedOver {
+		t.Errorf("Превышений порога: ожидалось %d, получено %d", expectedOver, over)
+	}
+}
+
+// TestClip_InvalidLimit проверяет панику при некорректном пороге
+func TestClip_InvalidLimit(t *testing.T) {
+	for _, limit := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Порог %f: ожидалась паника", limit)
+				}
+			}()
+			Clip([]float64{1}, limit)
+		}()
+	}
+}
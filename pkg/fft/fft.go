@@ -1 +1,105 @@
+// Package fft реализует быстрое преобразование Фурье
 package fft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// FFT вычисляет прямое дискретное преобразование Фурье
+// X[k] = Σ x[n]·e^(-j2πkn/N) итеративным алгоритмом Кули-Тьюки по основанию 2.
+// Длина x должна быть степенью двойки; входной срез не изменяется
+func FFT(x []complex128) []complex128 {
+	checkPowerOfTwo(len(x))
+
+	result := append([]complex128{}, x...)
+	transform(result, false)
+	return result
+}
+
+// IFFT вычисляет обратное дискретное преобразование Фурье с нормировкой 1/N,
+// так что IFFT(FFT(x)) == x. Длина x должна быть степенью двойки
+func IFFT(x []complex128) []complex128 {
+	checkPowerOfTwo(len(x))
+
+	result := append([]complex128{}, x...)
+	transform(result, true)
+
+	scale := complex(1/float64(len(result)), 0)
+	for i := range result {
+		result[i] *= scale
+	}
+	return result
+}
+
+// RFFT вычисляет БПФ вещественного сигнала и возвращает неизбыточную половину
+// спектра: N/2+1 отсчетов от постоянной составляющей до частоты Найквиста.
+// Остальные отсчеты являются комплексно сопряженными. Длина x должна быть степенью двойки
+func RFFT(x []float64) []complex128 {
+	checkPowerOfTwo(len(x))
+
+	result := make([]complex128, len(x))
+	for i, v := range x {
+		result[i] = complex(v, 0)
+	}
+	transform(result, false)
+	return result[:len(x)/2+1]
+}
+
+// IsPowerOfTwo проверяет, является ли n положительной степенью двойки
+func IsPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// NextPowerOfTwo возвращает наименьшую степень двойки, не меньшую n
+func NextPowerOfTwo(n int) int {
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+	return size
+}
+
+// checkPowerOfTwo паникует, если длина не является степенью двойки
+func checkPowerOfTwo(n int) {
+	if !IsPowerOfTwo(n) {
+		panic("FFT: length must be a power of two")
+	}
+}
+
+// transform выполняет БПФ по основанию 2 на месте без нормировки
+func transform(x []complex128, inverse bool) {
+	n := len(x)
+
+	// Перестановка с обращением битов
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j |= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+
+	// Бабочки: поворачивающие множители вычисляются напрямую,
+	// чтобы не накапливать погрешность при больших N
+	for size := 2; size <= n; size <<= 1 {
+		half := size / 2
+		for k := 0; k < half; k++ {
+			w := cmplx.Rect(1, sign*2*math.Pi*float64(k)/float64(size))
+			for start := 0; start < n; start += size {
+				even := x[start+k]
+				odd := x[start+k+half] * w
+				x[start+k] = even + odd
+				x[start+k+half] = even - odd
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// FFTAny вычисляет прямое дискретное преобразование Фурье для сигнала
+// произвольной длины. Степени двойки обрабатываются алгоритмом по основанию 2,
+// составные длины - смешанным основанием (разбиение по наименьшему простому
+// множителю), простые длины - алгоритмом Блюстейна (chirp-z) через свертку
+// на БПФ по основанию 2. Входной срез не изменяется
+func FFTAny(x []complex128) []complex128 {
+	if len(x) == 0 {
+		return []complex128{}
+	}
+	return mixedRadix(x)
+}
+
+// IFFTAny вычисляет обратное дискретное преобразование Фурье произвольной длины
+// с нормировкой 1/N, так что IFFTAny(FFTAny(x)) == x
+func IFFTAny(x []complex128) []complex128 {
+	if len(x) == 0 {
+		return []complex128{}
+	}
+
+	// IDFT(X) = conj(DFT(conj(X))) / N
+	conj := make([]complex128, len(x))
+	for i, v := range x {
+		conj[i] = cmplx.Conj(v)
+	}
+	result := mixedRadix(conj)
+
+	scale := 1 / float64(len(x))
+	for i, v := range result {
+		result[i] = complex(real(v)*scale, -imag(v)*scale)
+	}
+	return result
+}
+
+// mixedRadix рекурсивно вычисляет ДПФ с прореживанием по времени
+func mixedRadix(x []complex128) []complex128 {
+	n := len(x)
+	if IsPowerOfTwo(n) {
+		result := append([]complex128{}, x...)
+		transform(result, false)
+		return result
+	}
+
+	p := smallestFactor(n)
+	if p == n {
+		return bluestein(x)
+	}
+
+	// Разбиваем сигнал на p прореженных подпоследовательностей длины m
+	m := n / p
+	subSpectra := make([][]complex128, p)
+	sub := make([]complex128, m)
+	for r := 0; r < p; r++ {
+		for i := 0; i < m; i++ {
+			sub[i] = x[i*p+r]
+		}
+		subSpectra[r] = mixedRadix(sub)
+	}
+
+	// X[k] = Σ_r W_n^(rk) · Y_r[k mod m]
+	result := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		var sum complex128
+		for r := 0; r < p; r++ {
+			twiddle := cmplx.Rect(1, -2*math.Pi*float64(r*k%n)/float64(n))
+			sum += twiddle * subSpectra[r][k%m]
+		}
+		result[k] = sum
+	}
+	return result
+}
+
+// bluestein вычисляет ДПФ произвольной длины через свертку с ЛЧМ-последовательностью
+func bluestein(x []complex128) []complex128 {
+	n := len(x)
+	size := NextPowerOfTwo(2*n - 1)
+
+	// chirp[k] = e^(-jπk²/n); k² берется по модулю 2n для сохранения точности
+	chirp := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		chirp[k] = cmplx.Rect(1, -math.Pi*float64(k*k%(2*n))/float64(n))
+	}
+
+	a := make([]complex128, size)
+	for k := 0; k < n; k++ {
+		a[k] = x[k] * chirp[k]
+	}
+
+	b := make([]complex128, size)
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[size-k] = b[k]
+	}
+
+	transform(a, false)
+	transform(b, false)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	transform(a, true)
+
+	scale := complex(1/float64(size), 0)
+	result := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		result[k] = a[k] * scale * chirp[k]
+	}
+	return result
+}
+
+// smallestFactor возвращает наименьший простой делитель n (n > 1)
+func smallestFactor(n int) int {
+	if n%2 == 0 {
+		return 2
+	}
+	for p := 3; p*p <= n; p += 2 {
+		if n%p == 0 {
+			return p
+		}
+	}
+	return n
+}
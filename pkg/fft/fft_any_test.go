@@ -0,0 +1,69 @@
+package fft
+
+import (
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// TestFFTAny_MatchesDFT проверяет совпадение с ДПФ по определению для произвольных длин
+func TestFFTAny_MatchesDFT(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	tests := []struct {
+		name string
+		n    int
+	}{
+		{"Один отсчет", 1},
+		{"Простое 7", 7},
+		{"Составное 12", 12},
+		{"Степень двойки 64", 64},
+		{"Составное 2000", 2000},
+		{"Составное 4410", 4410},
+		{"Простое 4409", 4409},
+		{"Два простых множителя 2·997", 1994},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := make([]complex128, tt.n)
+			for i := range x {
+				x[i] = complex(rng.Float64()-0.5, rng.Float64()-0.5)
+			}
+
+			got := FFTAny(x)
+			want := naiveDFT(x)
+			if len(got) != len(want) {
+				t.Fatalf("Длина: ожидалось %d, получено %d", len(want), len(got))
+			}
+			for k := range want {
+				if cmplx.Abs(got[k]-want[k]) > 1e-8 {
+					t.Fatalf("Бин %d: ожидалось %v, получено %v", k, want[k], got[k])
+				}
+			}
+		})
+	}
+
+	if got := FFTAny(nil); got == nil || len(got) != 0 {
+		t.Errorf("Пустой вход: ожидался пустой срез, получено %v", got)
+	}
+}
+
+// TestIFFTAny_RoundTrip проверяет тождество IFFTAny(FFTAny(x)) == x
+func TestIFFTAny_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+
+	for _, n := range []int{3, 100, 128, 1009} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(rng.NormFloat64(), rng.NormFloat64())
+		}
+
+		restored := IFFTAny(FFTAny(x))
+		for i := range x {
+			if cmplx.Abs(restored[i]-x[i]) > 1e-10 {
+				t.Fatalf("N=%d, отсчет %d: ожидалось %v, получено %v", n, i, x[i], restored[i])
+			}
+		}
+	}
+}
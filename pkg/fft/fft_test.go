@@ -0,0 +1,121 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// naiveDFT вычисляет ДПФ по определению
+func naiveDFT(x []complex128) []complex128 {
+	n := len(x)
+	result := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		var sum complex128
+		for i, v := range x {
+			sum += v * cmplx.Rect(1, -2*math.Pi*float64(k*i%n)/float64(n))
+		}
+		result[k] = sum
+	}
+	return result
+}
+
+// TestFFT_Cosine проверяет, что косинус на частоте бина дает единственный ненулевой бин
+func TestFFT_Cosine(t *testing.T) {
+	const n, bin = 64, 5
+	signal := make([]float64, n)
+	for i := range signal {
+		signal[i] = 3 * math.Cos(2*math.Pi*bin*float64(i)/n)
+	}
+
+	spectrum := RFFT(signal)
+	if len(spectrum) != n/2+1 {
+		t.Fatalf("Длина RFFT: ожидалось %d, получено %d", n/2+1, len(spectrum))
+	}
+
+	for k, v := range spectrum {
+		expected := 0.0
+		if k == bin {
+			expected = 3 * n / 2
+		}
+		if math.Abs(cmplx.Abs(v)-expected) > 1e-9 {
+			t.Errorf("Бин %d: ожидалась амплитуда %f, получено %f", k, expected, cmplx.Abs(v))
+		}
+	}
+}
+
+// TestFFT_MatchesDFT проверяет совпадение с ДПФ по определению
+func TestFFT_MatchesDFT(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{1, 2, 4, 8, 32, 256} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(rng.Float64()-0.5, rng.Float64()-0.5)
+		}
+
+		got := FFT(x)
+		want := naiveDFT(x)
+		for k := range want {
+			if cmplx.Abs(got[k]-want[k]) > 1e-9 {
+				t.Errorf("N=%d, бин %d: ожидалось %v, получено %v", n, k, want[k], got[k])
+			}
+		}
+	}
+}
+
+// TestFFT_RoundTrip проверяет тождество IFFT(FFT(x)) == x
+func TestFFT_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	x := make([]complex128, 1024)
+	for i := range x {
+		x[i] = complex(rng.NormFloat64(), rng.NormFloat64())
+	}
+	original := append([]complex128{}, x...)
+
+	restored := IFFT(FFT(x))
+	for i := range x {
+		if cmplx.Abs(restored[i]-original[i]) > 1e-12 {
+			t.Fatalf("Отсчет %d: ожидалось %v, получено %v", i, original[i], restored[i])
+		}
+		if x[i] != original[i] {
+			t.Fatalf("FFT изменил входной срез в позиции %d", i)
+		}
+	}
+}
+
+// TestFFT_InvalidLength проверяет панику при длине, не являющейся степенью двойки
+func TestFFT_InvalidLength(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"FFT", func() { FFT(make([]complex128, 12)) }},
+		{"IFFT", func() { IFFT(make([]complex128, 0)) }},
+		{"RFFT", func() { RFFT(make([]float64, 100)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+// BenchmarkFFT измеряет производительность БПФ на 4096 отсчетах
+func BenchmarkFFT(b *testing.B) {
+	x := make([]complex128, 4096)
+	for i := range x {
+		x[i] = complex(math.Sin(0.1*float64(i)), 0)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FFT(x)
+	}
+}
@@ -0,0 +1,97 @@
+package filters
+
+import "math"
+
+// BilinearTransform переводит аналоговую передаточную функцию H(s) в цифровую H(z)
+// подстановкой s = 2*fs * (1 - z^-1) / (1 + z^-1)
+// bAnalog, aAnalog: коэффициенты числителя и знаменателя H(s) по убыванию степеней s,
+// например H(s) = 1/(s/wc + 1) задается как bAnalog = [1], aAnalog = [1/wc, 1]
+// fs: частота дискретизации в герцах
+// Возвращает коэффициенты [b0, b1, ...] и [1, a1, ...] по степеням z^-1
+func BilinearTransform(bAnalog, aAnalog []float64, fs float64) (bDigital, aDigital []float64) {
+	if fs <= 0 {
+		panic("BilinearTransform: sample rate must be positive")
+	}
+	return bilinear(bAnalog, aAnalog, 2*fs)
+}
+
+// BilinearTransformPrewarp выполняет билинейное преобразование с предыскажением
+// частоты: аналоговая частота prewarpHz отображается точно в ту же цифровую частоту
+// prewarpHz: частота предыскажения в герцах (0 < prewarpHz < fs/2)
+func BilinearTransformPrewarp(bAnalog, aAnalog []float64, fs, prewarpHz float64) (bDigital, aDigital []float64) {
+	if fs <= 0 {
+		panic("BilinearTransform: sample rate must be positive")
+	}
+	if prewarpHz <= 0 || prewarpHz >= fs/2 {
+		panic("BilinearTransform: prewarp frequency must be between 0 and fs/2")
+	}
+
+	// s = K * (1 - z^-1) / (1 + z^-1), K = w0 / tan(w0 / (2*fs))
+	w0 := 2 * math.Pi * prewarpHz
+	return bilinear(bAnalog, aAnalog, w0/math.Tan(w0/(2*fs)))
+}
+
+// bilinear выполняет подстановку s = k * (1 - z^-1) / (1 + z^-1)
+func bilinear(bAnalog, aAnalog []float64, k float64) ([]float64, []float64) {
+	if len(bAnalog) == 0 || len(aAnalog) == 0 {
+		panic("BilinearTransform: coefficients cannot be empty")
+	}
+
+	order := max(len(bAnalog), len(aAnalog)) - 1
+	bDigital := bilinearPoly(bAnalog, order, k)
+	aDigital := bilinearPoly(aAnalog, order, k)
+
+	// Нормализуем коэффициенты, чтобы a[0] = 1
+	normalizer := aDigital[0]
+	if normalizer == 0 {
+		panic("BilinearTransform: leading denominator coefficient is zero")
+	}
+	for i := range bDigital {
+		bDigital[i] /= normalizer
+	}
+	for i := range aDigital {
+		aDigital[i] /= normalizer
+	}
+
+	return bDigital, aDigital
+}
+
+// bilinearPoly преобразует полином по s (по убыванию степеней) в полином по z^-1,
+// домноженный на (1 + z^-1)^order:
+// c * s^p -> c * k^p * (1 - z^-1)^p * (1 + z^-1)^(order - p)
+func bilinearPoly(coeffs []float64, order int, k float64) []float64 {
+	result := make([]float64, order+1)
+	degree := len(coeffs) - 1
+
+	for i, c := range coeffs {
+		if c == 0 {
+			continue
+		}
+		p := degree - i // Степень s для данного коэффициента
+
+		term := []float64{c * math.Pow(k, float64(p))}
+		for j := 0; j < p; j++ {
+			term = polyMul(term, []float64{1, -1})
+		}
+		for j := 0; j < order-p; j++ {
+			term = polyMul(term, []float64{1, 1})
+		}
+
+		for j, v := range term {
+			result[j] += v
+		}
+	}
+
+	return result
+}
+
+// polyMul перемножает два полинома
+func polyMul(a, b []float64) []float64 {
+	result := make([]float64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			result[i+j] += x * y
+		}
+	}
+	return result
+}
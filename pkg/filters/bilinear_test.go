@@ -0,0 +1,78 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// TestBilinearTransformFirstOrderLowPass сравнивает преобразование H(s) = 1/(1 + s/wc)
+// с коэффициентами NewFirstOrderLowPass
+func TestBilinearTransformFirstOrderLowPass(t *testing.T) {
+	fs := 8000.0
+	for _, fcHz := range []float64{100, 800, 2500} {
+		expected := NewFirstOrderLowPass(fcHz / fs)
+
+		// Без предыскажения: аналоговая частота среза заранее предыскажена вручную
+		wa := 2 * fs * math.Tan(math.Pi*fcHz/fs)
+		b, a := BilinearTransform([]float64{1}, []float64{1 / wa, 1}, fs)
+		compareCoeffs(t, "b", b, expected.GetBCoeffs())
+		compareCoeffs(t, "a", a, expected.GetACoeffs())
+
+		// С предыскажением на частоте среза
+		wc := 2 * math.Pi * fcHz
+		b, a = BilinearTransformPrewarp([]float64{1}, []float64{1 / wc, 1}, fs, fcHz)
+		compareCoeffs(t, "b (prewarp)", b, expected.GetBCoeffs())
+		compareCoeffs(t, "a (prewarp)", a, expected.GetACoeffs())
+	}
+}
+
+// TestBilinearTransformSecondOrder проверяет преобразование аналогового
+// ФНЧ Баттерворта 2-го порядка H(s) = wc^2 / (s^2 + sqrt(2)*wc*s + wc^2)
+func TestBilinearTransformSecondOrder(t *testing.T) {
+	fs := 48000.0
+	fcHz := 1000.0
+	wc := 2 * math.Pi * fcHz
+
+	b, a := BilinearTransformPrewarp(
+		[]float64{wc * wc},
+		[]float64{1, math.Sqrt2 * wc, wc * wc},
+		fs, fcHz,
+	)
+	filter := NewIIRFilter(b, a)
+
+	if dc := cmplx.Abs(filter.GetFrequencyResponse(0)); math.Abs(dc-1) > 1e-12 {
+		t.Errorf("Усиление на DC: ожидалось 1, получено %f", dc)
+	}
+	if cut := cmplx.Abs(filter.GetFrequencyResponse(fcHz / fs)); math.Abs(cut-1/math.Sqrt2) > 1e-9 {
+		t.Errorf("Усиление на fc: ожидалось %f, получено %f", 1/math.Sqrt2, cut)
+	}
+
+	// Совпадает с биквадратным ФНЧ при Q = 1/sqrt(2)
+	expected := NewSecondOrderLowPass(fcHz/fs, 1/math.Sqrt2)
+	compareCoeffs(t, "b", b, expected.GetBCoeffs())
+	compareCoeffs(t, "a", a, expected.GetACoeffs())
+}
+
+// TestBilinearTransformInvalidParams проверяет панику при неверных параметрах
+func TestBilinearTransformInvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		create func()
+	}{
+		{"нулевая частота дискретизации", func() { BilinearTransform([]float64{1}, []float64{1, 1}, 0) }},
+		{"пустой знаменатель", func() { BilinearTransform([]float64{1}, nil, 8000) }},
+		{"предыскажение выше Найквиста", func() { BilinearTransformPrewarp([]float64{1}, []float64{1, 1}, 8000, 5000) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.create()
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package filters
+
+import "math"
+
+// BiquadCascade представляет собой каскад последовательно соединенных звеньев
+// второго порядка (SOS). Фильтры высокого порядка в такой форме численно
+// устойчивее, чем одно звено с длинными массивами коэффициентов
+type BiquadCascade struct {
+	stages []*IIRFilter // Звенья каскада в порядке обработки
+}
+
+// NewBiquadCascade создает каскад из заданных звеньев
+func NewBiquadCascade(stages ...*IIRFilter) *BiquadCascade {
+	if len(stages) == 0 {
+		panic("BiquadCascade: at least one stage is required")
+	}
+	for _, stage := range stages {
+		if stage == nil {
+			panic("BiquadCascade: stage cannot be nil")
+		}
+	}
+
+	return &BiquadCascade{
+		stages: append([]*IIRFilter{}, stages...),
+	}
+}
+
+// Tick применяет каскад к одному новому отсчету
+func (c *BiquadCascade) Tick(input float64) float64 {
+	output := input
+	for _, stage := range c.stages {
+		output = stage.Tick(output)
+	}
+	return output
+}
+
+// Process обрабатывает весь срез входных данных
+func (c *BiquadCascade) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = c.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние всех звеньев
+func (c *BiquadCascade) Reset() {
+	for _, stage := range c.stages {
+		stage.Reset()
+	}
+}
+
+// IsStable проверяет устойчивость каскада (устойчивы все звенья)
+func (c *BiquadCascade) IsStable() bool {
+	for _, stage := range c.stages {
+		if !stage.IsStable() {
+			return false
+		}
+	}
+	return true
+}
+
+// StabilityMargin возвращает наименьший запас устойчивости среди звеньев
+// (см. IIRFilter.StabilityMargin)
+func (c *BiquadCascade) StabilityMargin() float64 {
+	margin := 1.0
+	for _, stage := range c.stages {
+		margin = math.Min(margin, stage.StabilityMargin())
+	}
+	return margin
+}
+
+// GetFrequencyResponse вычисляет частотную характеристику каскада
+// как произведение характеристик звеньев
+func (c *BiquadCascade) GetFrequencyResponse(freq float64) complex128 {
+	response := complex(1, 0)
+	for _, stage := range c.stages {
+		response *= stage.GetFrequencyResponse(freq)
+	}
+	return response
+}
+
+// GetGroupDelay вычисляет групповую задержку каскада (сумма задержек звеньев)
+func (c *BiquadCascade) GetGroupDelay(freq float64) float64 {
+	var delay float64
+	for _, stage := range c.stages {
+		delay += stage.GetGroupDelay(freq)
+	}
+	return delay
+}
+
+// GetStages возвращает копию среза звеньев каскада
+func (c *BiquadCascade) GetStages() []*IIRFilter {
+	return append([]*IIRFilter{}, c.stages...)
+}
+
+// GetOrder возвращает суммарный порядок каскада
+func (c *BiquadCascade) GetOrder() int {
+	order := 0
+	for _, stage := range c.stages {
+		order += stage.GetOrder()
+	}
+	return order
+}
@@ -0,0 +1,98 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// TestBiquadCascade_MatchesSeries проверяет, что каскад эквивалентен
+// последовательному применению звеньев
+func TestBiquadCascade_MatchesSeries(t *testing.T) {
+	cascade := NewBiquadCascade(
+		NewSecondOrderLowPass(0.1, 0.707),
+		NewSecondOrderHighPass(0.02, 0.707),
+	)
+	first := NewSecondOrderLowPass(0.1, 0.707)
+	second := NewSecondOrderHighPass(0.02, 0.707)
+
+	input := make([]float64, 50)
+	for i := range input {
+		input[i] = math.Sin(0.2*float64(i)) + 0.5
+	}
+
+	output := cascade.Process(input)
+	for i, val := range input {
+		expected := second.Tick(first.Tick(val))
+		if math.Abs(output[i]-expected) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected, output[i])
+		}
+	}
+
+	// После сброса каскад ведет себя как новый
+	cascade.Reset()
+	first.Reset()
+	second.Reset()
+	if got, want := cascade.Tick(1.0), second.Tick(first.Tick(1.0)); math.Abs(got-want) > 1e-12 {
+		t.Errorf("После Reset: ожидалось %f, получено %f", want, got)
+	}
+
+	if cascade.GetOrder() != 4 {
+		t.Errorf("Порядок каскада: ожидалось 4, получено %d", cascade.GetOrder())
+	}
+}
+
+// TestBiquadCascade_FrequencyResponse проверяет произведение частотных характеристик
+func TestBiquadCascade_FrequencyResponse(t *testing.T) {
+	lp := NewSecondOrderLowPass(0.15, 1.2)
+	bp := NewSecondOrderBandPass(0.1, 2.0)
+	cascade := NewBiquadCascade(lp, bp)
+
+	for _, freq := range []float64{0, 0.05, 0.1, 0.2, 0.35, 0.5} {
+		expected := lp.GetFrequencyResponse(freq) * bp.GetFrequencyResponse(freq)
+		got := cascade.GetFrequencyResponse(freq)
+		if cmplx.Abs(got-expected) > 1e-12 {
+			t.Errorf("Частота %.2f: ожидалось %v, получено %v", freq, expected, got)
+		}
+
+		expectedDelay := lp.GetGroupDelay(freq) + bp.GetGroupDelay(freq)
+		if gd := cascade.GetGroupDelay(freq); math.Abs(gd-expectedDelay) > 1e-9 {
+			t.Errorf("Групповая задержка на %.2f: ожидалось %f, получено %f", freq, expectedDelay, gd)
+		}
+	}
+}
+
+// TestBiquadCascade_IsStable проверяет устойчивость каскада
+func TestBiquadCascade_IsStable(t *testing.T) {
+	stable := NewBiquadCascade(NewSecondOrderLowPass(0.1, 0.707), NewFirstOrderLowPass(0.2))
+	if !stable.IsStable() {
+		t.Error("Каскад из устойчивых звеньев должен быть устойчив")
+	}
+
+	unstable := NewBiquadCascade(
+		NewSecondOrderLowPass(0.1, 0.707),
+		NewIIRFilter([]float64{1}, []float64{1, -1.5}),
+	)
+	if unstable.IsStable() {
+		t.Error("Каскад с неустойчивым звеном должен быть неустойчив")
+	}
+
+	// Запас каскада определяется наихудшим звеном
+	if margin := stable.StabilityMargin(); margin <= 0 {
+		t.Errorf("Запас устойчивого каскада: ожидалось > 0, получено %g", margin)
+	}
+	if margin := unstable.StabilityMargin(); math.Abs(margin+0.5) > 1e-9 {
+		t.Errorf("Запас неустойчивого каскада: ожидалось -0.5, получено %g", margin)
+	}
+}
+
+// TestBiquadCascade_Empty проверяет панику при отсутствии звеньев
+func TestBiquadCascade_Empty(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ожидалась паника при пустом каскаде")
+		}
+	}()
+
+	_ = NewBiquadCascade()
+}
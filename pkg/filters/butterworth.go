@@ -0,0 +1,59 @@
+package filters
+
+import "math"
+
+// NewButterworthLowPass создает ФНЧ Баттерворта произвольного порядка
+// в виде каскада биквадратных звеньев (и звена 1-го порядка для нечетного порядка)
+// fc: частота среза по уровню -3 дБ (0 < fc < 0.5)
+// order: порядок фильтра (order >= 1)
+func NewButterworthLowPass(fc float64, order int) *BiquadCascade {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if order < 1 {
+		panic("IIRFilter: order must be at least 1")
+	}
+
+	stages := make([]*IIRFilter, 0, (order+1)/2)
+	for _, q := range butterworthQ(order) {
+		stages = append(stages, NewSecondOrderLowPass(fc, q))
+	}
+	if order%2 == 1 {
+		stages = append(stages, NewFirstOrderLowPass(fc))
+	}
+
+	return NewBiquadCascade(stages...)
+}
+
+// NewButterworthHighPass создает ФВЧ Баттерворта произвольного порядка
+// в виде каскада биквадратных звеньев (и звена 1-го порядка для нечетного порядка)
+// fc: частота среза по уровню -3 дБ (0 < fc < 0.5)
+// order: порядок фильтра (order >= 1)
+func NewButterworthHighPass(fc float64, order int) *BiquadCascade {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if order < 1 {
+		panic("IIRFilter: order must be at least 1")
+	}
+
+	stages := make([]*IIRFilter, 0, (order+1)/2)
+	for _, q := range butterworthQ(order) {
+		stages = append(stages, NewSecondOrderHighPass(fc, q))
+	}
+	if order%2 == 1 {
+		stages = append(stages, NewFirstOrderHighPass(fc))
+	}
+
+	return NewBiquadCascade(stages...)
+}
+
+// butterworthQ возвращает добротности биквадратных звеньев фильтра Баттерворта
+// заданного порядка: Q_k = 1 / (2*sin((2k+1)*pi/(2*order)))
+func butterworthQ(order int) []float64 {
+	qs := make([]float64, order/2)
+	for k := range qs {
+		qs[k] = 1.0 / (2.0 * math.Sin(float64(2*k+1)*math.Pi/float64(2*order)))
+	}
+	return qs
+}
@@ -0,0 +1,95 @@
+package filters
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// gainDB возвращает модуль частотной характеристики в дБ
+func gainDB(h complex128) float64 {
+	return 20 * math.Log10(cmplx.Abs(h))
+}
+
+// TestButterworthLowPass проверяет частоту среза и крутизну спада ФНЧ Баттерворта
+func TestButterworthLowPass(t *testing.T) {
+	fc := 0.01
+	for _, order := range []int{1, 2, 3, 4, 5, 8} {
+		t.Run(fmt.Sprintf("order_%d", order), func(t *testing.T) {
+			filter := NewButterworthLowPass(fc, order)
+
+			if filter.GetOrder() != order {
+				t.Errorf("Порядок: ожидалось %d, получено %d", order, filter.GetOrder())
+			}
+			if !filter.IsStable() {
+				t.Error("Фильтр Баттерворта должен быть устойчив")
+			}
+
+			if dc := gainDB(filter.GetFrequencyResponse(0)); math.Abs(dc) > 1e-9 {
+				t.Errorf("Усиление на DC: ожидалось 0 дБ, получено %f дБ", dc)
+			}
+			if cut := gainDB(filter.GetFrequencyResponse(fc)); math.Abs(cut+3.0103) > 1e-3 {
+				t.Errorf("Усиление на fc: ожидалось -3.01 дБ, получено %f дБ", cut)
+			}
+
+			// Крутизна спада в полосе задерживания около 6*order дБ/октаву
+			slope := gainDB(filter.GetFrequencyResponse(0.04)) - gainDB(filter.GetFrequencyResponse(0.08))
+			expected := 6.02 * float64(order)
+			if math.Abs(slope-expected) > 0.1*expected {
+				t.Errorf("Крутизна спада: ожидалось ~%.1f дБ/окт, получено %.1f дБ/окт", expected, slope)
+			}
+		})
+	}
+}
+
+// TestButterworthHighPass проверяет частоту среза и крутизну спада ФВЧ Баттерворта
+func TestButterworthHighPass(t *testing.T) {
+	fc := 0.2
+	for _, order := range []int{1, 2, 4, 7} {
+		t.Run(fmt.Sprintf("order_%d", order), func(t *testing.T) {
+			filter := NewButterworthHighPass(fc, order)
+
+			if !filter.IsStable() {
+				t.Error("Фильтр Баттерворта должен быть устойчив")
+			}
+			if nyq := gainDB(filter.GetFrequencyResponse(0.5)); math.Abs(nyq) > 1e-9 {
+				t.Errorf("Усиление на Найквисте: ожидалось 0 дБ, получено %f дБ", nyq)
+			}
+			if cut := gainDB(filter.GetFrequencyResponse(fc)); math.Abs(cut+3.0103) > 1e-3 {
+				t.Errorf("Усиление на fc: ожидалось -3.01 дБ, получено %f дБ", cut)
+			}
+
+			slope := gainDB(filter.GetFrequencyResponse(0.01)) - gainDB(filter.GetFrequencyResponse(0.005))
+			expected := 6.02 * float64(order)
+			if math.Abs(slope-expected) > 0.1*expected {
+				t.Errorf("Крутизна спада: ожидалось ~%.1f дБ/окт, получено %.1f дБ/окт", expected, slope)
+			}
+		})
+	}
+}
+
+// TestButterworthInvalidParams проверяет панику при неверных параметрах
+func TestButterworthInvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		create func()
+	}{
+		{"ФНЧ: нулевая частота", func() { NewButterworthLowPass(0, 4) }},
+		{"ФНЧ: частота Найквиста", func() { NewButterworthLowPass(0.5, 4) }},
+		{"ФНЧ: нулевой порядок", func() { NewButterworthLowPass(0.1, 0) }},
+		{"ФВЧ: отрицательная частота", func() { NewButterworthHighPass(-0.1, 2) }},
+		{"ФВЧ: отрицательный порядок", func() { NewButterworthHighPass(0.1, -1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.create()
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package filters
+
+import "math"
+
+// NewChebyshev1LowPass создает ФНЧ Чебышева I рода в виде каскада биквадратных звеньев
+// (и звена 1-го порядка для нечетного порядка)
+// fc: граница полосы пропускания, на которой усиление равно -rippleDB (0 < fc < 0.5)
+// order: порядок фильтра (order >= 1)
+// rippleDB: неравномерность в полосе пропускания в дБ (rippleDB > 0)
+func NewChebyshev1LowPass(fc float64, order int, rippleDB float64) *BiquadCascade {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if order < 1 {
+		panic("IIRFilter: order must be at least 1")
+	}
+	if rippleDB <= 0 {
+		panic("IIRFilter: ripple must be positive")
+	}
+
+	// Полюса аналогового прототипа с границей полосы пропускания wc = 1:
+	// p_k = -sinh(mu)*sin(theta_k) + j*cosh(mu)*cos(theta_k)
+	epsilon := math.Sqrt(math.Pow(10, rippleDB/10) - 1)
+	mu := math.Asinh(1/epsilon) / float64(order)
+
+	// Частота среза аналогового фильтра; предыскажение выполняется
+	// в BilinearTransformPrewarp (частота дискретизации нормирована к 1)
+	wc := 2 * math.Pi * fc
+
+	stages := make([]*IIRFilter, 0, (order+1)/2)
+	for k := 0; k < order/2; k++ {
+		theta := float64(2*k+1) * math.Pi / float64(2*order)
+		re := -math.Sinh(mu) * math.Sin(theta) * wc
+		im := math.Cosh(mu) * math.Cos(theta) * wc
+		magSq := re*re + im*im
+
+		// H(s) = |p|^2 / (s^2 - 2*Re(p)*s + |p|^2), единичное усиление на DC
+		b, a := BilinearTransformPrewarp([]float64{magSq}, []float64{1, -2 * re, magSq}, 1, fc)
+		stages = append(stages, NewIIRFilter(b, a))
+	}
+	if order%2 == 1 {
+		// Вещественный полюс: H(s) = -p / (s - p)
+		p := -math.Sinh(mu) * wc
+		b, a := BilinearTransformPrewarp([]float64{-p}, []float64{1, -p}, 1, fc)
+		stages = append(stages, NewIIRFilter(b, a))
+	}
+
+	// Для четного порядка усиление на DC равно минимуму пульсаций 1/sqrt(1+eps^2)
+	if order%2 == 0 {
+		gain := 1 / math.Sqrt(1+epsilon*epsilon)
+		b := stages[0].GetBCoeffs()
+		for i := range b {
+			b[i] *= gain
+		}
+		stages[0] = NewIIRFilter(b, stages[0].GetACoeffs())
+	}
+
+	return NewBiquadCascade(stages...)
+}
@@ -0,0 +1,86 @@
+package filters
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestChebyshev1LowPass проверяет неравномерность в полосе пропускания и спад в полосе задерживания
+func TestChebyshev1LowPass(t *testing.T) {
+	fc := 0.1
+	for _, tc := range []struct {
+		order  int
+		ripple float64
+	}{
+		{1, 1}, {2, 0.5}, {3, 1}, {4, 0.1}, {5, 3}, {8, 1},
+	} {
+		t.Run(fmt.Sprintf("order_%d_ripple_%.1f", tc.order, tc.ripple), func(t *testing.T) {
+			filter := NewChebyshev1LowPass(fc, tc.order, tc.ripple)
+
+			if filter.GetOrder() != tc.order {
+				t.Errorf("Порядок: ожидалось %d, получено %d", tc.order, filter.GetOrder())
+			}
+			if !filter.IsStable() {
+				t.Error("Фильтр Чебышева должен быть устойчив")
+			}
+
+			// Граница полосы пропускания: усиление равно -ripple
+			if edge := gainDB(filter.GetFrequencyResponse(fc)); math.Abs(edge+tc.ripple) > 1e-6 {
+				t.Errorf("Усиление на fc: ожидалось %.3f дБ, получено %.6f дБ", -tc.ripple, edge)
+			}
+
+			// Пульсации в полосе пропускания не превышают ripple
+			for freq := 0.0; freq <= fc; freq += fc / 500 {
+				g := gainDB(filter.GetFrequencyResponse(freq))
+				if g > 1e-9 || g < -tc.ripple-1e-6 {
+					t.Fatalf("Частота %.4f: усиление %.6f дБ вне диапазона [-%.2f, 0]", freq, g, tc.ripple)
+				}
+			}
+
+			// Монотонный спад в полосе задерживания
+			prev := gainDB(filter.GetFrequencyResponse(fc))
+			for freq := fc + 0.002; freq < 0.5; freq += 0.002 {
+				g := gainDB(filter.GetFrequencyResponse(freq))
+				if g > prev {
+					t.Fatalf("Частота %.3f: усиление выросло с %.3f до %.3f дБ", freq, prev, g)
+				}
+				prev = g
+			}
+		})
+	}
+}
+
+// TestChebyshev1SteeperThanButterworth проверяет более крутой спад по сравнению с Баттервортом
+func TestChebyshev1SteeperThanButterworth(t *testing.T) {
+	cheby := NewChebyshev1LowPass(0.1, 4, 1)
+	butter := NewButterworthLowPass(0.1, 4)
+
+	freq := 0.15
+	if c, b := gainDB(cheby.GetFrequencyResponse(freq)), gainDB(butter.GetFrequencyResponse(freq)); c >= b {
+		t.Errorf("На частоте %.2f Чебышев (%.2f дБ) должен подавлять сильнее Баттерворта (%.2f дБ)", freq, c, b)
+	}
+}
+
+// TestChebyshev1InvalidParams проверяет панику при неверных параметрах
+func TestChebyshev1InvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		create func()
+	}{
+		{"нулевая частота", func() { NewChebyshev1LowPass(0, 4, 1) }},
+		{"нулевой порядок", func() { NewChebyshev1LowPass(0.1, 0, 1) }},
+		{"нулевые пульсации", func() { NewChebyshev1LowPass(0.1, 4, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.create()
+		})
+	}
+}
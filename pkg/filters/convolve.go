@@ -0,0 +1,71 @@
+package filters
+
+// ConvolveMode определяет, какая часть полной свертки возвращается
+type ConvolveMode int
+
+const (
+	ConvolveFull  ConvolveMode = iota // Полная свертка: len(signal)+len(kernel)-1 отсчетов
+	ConvolveSame                      // Центральная часть длиной max(len(signal), len(kernel))
+	ConvolveValid                     // Только отсчеты без краевых эффектов: max-min+1 отсчетов
+)
+
+// String возвращает строковое представление режима свертки
+func (m ConvolveMode) String() string {
+	switch m {
+	case ConvolveFull:
+		return "Full"
+	case ConvolveSame:
+		return "Same"
+	case ConvolveValid:
+		return "Valid"
+	default:
+		return "Unknown"
+	}
+}
+
+// Convolve вычисляет полную линейную свертку сигнала с ядром КИХ-фильтра.
+// Длина результата равна len(signal)+len(kernel)-1; первые len(signal)
+// отсчетов совпадают с выходом NewFIRFilter(kernel).Process(signal)
+func Convolve(signal, kernel []float64) []float64 {
+	if len(signal) == 0 || len(kernel) == 0 {
+		return []float64{}
+	}
+
+	output := make([]float64, len(signal)+len(kernel)-1)
+	for i, s := range signal {
+		if s == 0 {
+			continue
+		}
+		for j, k := range kernel {
+			output[i+j] += s * k
+		}
+	}
+	return output
+}
+
+// ConvolveWithMode вычисляет линейную свертку и обрезает результат согласно режиму
+func ConvolveWithMode(signal, kernel []float64, mode ConvolveMode) []float64 {
+	return trimConvolution(Convolve(signal, kernel), len(signal), len(kernel), mode)
+}
+
+// trimConvolution обрезает полную свертку согласно режиму
+func trimConvolution(full []float64, n, m int, mode ConvolveMode) []float64 {
+	if len(full) == 0 {
+		return full
+	}
+
+	switch mode {
+	case ConvolveFull:
+		return full
+	case ConvolveSame:
+		length := max(n, m)
+		start := (len(full) - length) / 2
+		return full[start : start+length]
+	case ConvolveValid:
+		length := max(n, m) - min(n, m) + 1
+		start := min(n, m) - 1
+		return full[start : start+length]
+	default:
+		panic("Convolve: unknown convolution mode")
+	}
+}
@@ -0,0 +1,95 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestConvolveMatchesFIR проверяет совпадение свертки с выходом КИХ-фильтра
+func TestConvolveMatchesFIR(t *testing.T) {
+	kernel := DesignLowPassFIR(0.1, 21, nil)
+	signal := make([]float64, 100)
+	for i := range signal {
+		signal[i] = math.Sin(0.3*float64(i)) + 0.2*float64(i%5)
+	}
+
+	full := Convolve(signal, kernel)
+	if len(full) != len(signal)+len(kernel)-1 {
+		t.Fatalf("Длина: ожидалось %d, получено %d", len(signal)+len(kernel)-1, len(full))
+	}
+
+	fir := NewFIRFilter(kernel).Process(signal)
+	for i := range fir {
+		if math.Abs(full[i]-fir[i]) > 1e-12 {
+			t.Errorf("Отсчет %d: свертка %f, КИХ-фильтр %f", i, full[i], fir[i])
+		}
+	}
+}
+
+// TestConvolveProperties проверяет коммутативность и ассоциативность свертки
+func TestConvolveProperties(t *testing.T) {
+	a := []float64{1, -2, 0.5}
+	b := []float64{0.25, 3}
+	c := []float64{-1, 0, 2, 1}
+
+	ab := Convolve(a, b)
+	ba := Convolve(b, a)
+	for i := range ab {
+		if math.Abs(ab[i]-ba[i]) > 1e-12 {
+			t.Errorf("Коммутативность нарушена в позиции %d: %f != %f", i, ab[i], ba[i])
+		}
+	}
+
+	left := Convolve(Convolve(a, b), c)
+	right := Convolve(a, Convolve(b, c))
+	if len(left) != len(right) {
+		t.Fatalf("Разная длина: %d и %d", len(left), len(right))
+	}
+	for i := range left {
+		if math.Abs(left[i]-right[i]) > 1e-12 {
+			t.Errorf("Ассоциативность нарушена в позиции %d: %f != %f", i, left[i], right[i])
+		}
+	}
+
+	// Свертка с единичным импульсом не изменяет сигнал
+	identity := Convolve(c, []float64{1})
+	for i := range c {
+		if identity[i] != c[i] {
+			t.Errorf("Свертка с единичным импульсом: позиция %d: %f != %f", i, identity[i], c[i])
+		}
+	}
+}
+
+// TestConvolveWithMode проверяет режимы обрезки свертки
+func TestConvolveWithMode(t *testing.T) {
+	signal := []float64{1, 2, 3, 4, 5}
+	kernel := []float64{1, 0, -1}
+	// Полная свертка: [1, 2, 2, 2, 2, -4, -5]
+
+	tests := []struct {
+		mode     ConvolveMode
+		expected []float64
+	}{
+		{ConvolveFull, []float64{1, 2, 2, 2, 2, -4, -5}},
+		{ConvolveSame, []float64{2, 2, 2, 2, -4}},
+		{ConvolveValid, []float64{2, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			got := ConvolveWithMode(signal, kernel, tt.mode)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Длина: ожидалось %d, получено %d (%v)", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if math.Abs(got[i]-tt.expected[i]) > 1e-12 {
+					t.Errorf("Позиция %d: ожидалось %f, получено %f", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+
+	if got := Convolve(nil, kernel); got == nil || len(got) != 0 {
+		t.Errorf("Пустой сигнал: ожидался пустой срез, получено %v", got)
+	}
+}
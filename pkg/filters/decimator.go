@@ -0,0 +1,71 @@
+package filters
+
+// decimatorTapsPerFactor определяет длину ФНЧ дециматора: 20*M+1 коэффициентов
+const decimatorTapsPerFactor = 20
+
+// Decimator понижает частоту дискретизации в целое число раз M.
+// Перед прореживанием сигнал пропускается через КИХ-ФНЧ с частотой среза 0.5/M
+// (новая частота Найквиста), подавляющий составляющие, которые иначе
+// наложились бы на полезный спектр. Свертка вычисляется только для
+// сохраняемых отсчетов
+type Decimator struct {
+	factor int        // Коэффициент децимации M
+	coeffs []float64  // Коэффициенты ФНЧ
+	line   *DelayLine // Линия задержки отсчетов сигнала
+	phase  int        // Номер входного отсчета по модулю M
+}
+
+// NewDecimator создает дециматор с коэффициентом factor (factor >= 1)
+func NewDecimator(factor int) *Decimator {
+	if factor < 1 {
+		panic("Decimator: factor must be at least 1")
+	}
+
+	coeffs := []float64{1}
+	if factor > 1 {
+		coeffs = DesignLowPassFIR(0.5/float64(factor), decimatorTapsPerFactor*factor+1, nil)
+	}
+
+	return &Decimator{
+		factor: factor,
+		coeffs: coeffs,
+		line:   NewDelayLine(len(coeffs)),
+	}
+}
+
+// Process обрабатывает срез входных отсчетов и возвращает один выходной
+// отсчет на каждые M входных. Состояние сохраняется между вызовами, поэтому
+// блоки произвольной длины сшиваются без разрывов
+func (d *Decimator) Process(input []float64) []float64 {
+	output := make([]float64, 0, len(input)/d.factor+1)
+
+	for _, val := range input {
+		d.line.Push(val)
+		if d.phase == 0 {
+			output = append(output, d.line.Dot(d.coeffs))
+		}
+
+		d.phase++
+		if d.phase == d.factor {
+			d.phase = 0
+		}
+	}
+
+	return output
+}
+
+// Reset сбрасывает состояние дециматора
+func (d *Decimator) Reset() {
+	d.line.Reset()
+	d.phase = 0
+}
+
+// GetFactor возвращает коэффициент децимации
+func (d *Decimator) GetFactor() int {
+	return d.factor
+}
+
+// GetCoeffs возвращает копию коэффициентов ФНЧ
+func (d *Decimator) GetCoeffs() []float64 {
+	return append([]float64{}, d.coeffs...)
+}
@@ -0,0 +1,76 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDecimator_AntiAlias проверяет подавление тона выше новой частоты Найквиста
+func TestDecimator_AntiAlias(t *testing.T) {
+	const (
+		fs     = 48000.0
+		factor = 6 // Новая частота 8000 Гц, Найквист 4000 Гц
+	)
+
+	// 6500 Гц без фильтрации наложился бы на 8000-6500 = 1500 Гц
+	input := make([]float64, 48000)
+	for i := range input {
+		tm := float64(i) / fs
+		input[i] = math.Sin(2*math.Pi*1000*tm) + math.Sin(2*math.Pi*6500*tm)
+	}
+
+	output := NewDecimator(factor).Process(input)
+	if len(output) != len(input)/factor {
+		t.Fatalf("Ожидалось %d отсчетов, получено %d", len(input)/factor, len(output))
+	}
+
+	// Пропускаем переходный процесс фильтра
+	settled := output[100:]
+	bank, err := NewGoertzelBank([]float64{1000, 1500}, fs/factor, len(settled))
+	if err != nil {
+		t.Fatalf("Ошибка создания набора фильтров: %v", err)
+	}
+	if err := bank.ProcessBlock(settled); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+
+	mags := bank.Magnitudes()
+	if math.Abs(mags[0]-1) > 0.01 {
+		t.Errorf("Тон 1000 Гц: амплитуда %f, ожидалось 1", mags[0])
+	}
+	if mags[1] > 0.003 {
+		t.Errorf("Наложение 6500 Гц на 1500 Гц: амплитуда %f (%.1f дБ)", mags[1], 20*math.Log10(mags[1]))
+	}
+}
+
+// TestDecimator_Blocks проверяет сшивку блоков произвольной длины
+func TestDecimator_Blocks(t *testing.T) {
+	input := make([]float64, 1000)
+	for i := range input {
+		input[i] = math.Sin(0.01*float64(i)) + 0.3*math.Cos(0.7*float64(i))
+	}
+
+	whole := NewDecimator(4).Process(input)
+
+	d := NewDecimator(4)
+	var pieces []float64
+	for _, size := range []int{1, 3, 7, 250, 739} {
+		pieces = append(pieces, d.Process(input[:size])...)
+		input = input[size:]
+	}
+
+	if len(pieces) != len(whole) {
+		t.Fatalf("Длина: ожидалось %d, получено %d", len(whole), len(pieces))
+	}
+	for i := range whole {
+		if math.Abs(pieces[i]-whole[i]) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, whole[i], pieces[i])
+		}
+	}
+
+	// Коэффициент 1 не изменяет сигнал
+	identity := NewDecimator(1).Process([]float64{1, 2, 3})
+	if len(identity) != 3 || identity[2] != 3 {
+		t.Errorf("Коэффициент 1: ожидалось [1 2 3], получено %v", identity)
+	}
+}
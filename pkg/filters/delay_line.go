@@ -0,0 +1,77 @@
+package filters
+
+// DelayLine - линия задержки на кольцевом буфере фиксированной длины.
+// Хранит последние Len() отсчетов: Get(0) - последний записанный отсчет,
+// Get(Len()-1) - самый старый. Используется КИХ-фильтрами и подходит для
+// построения собственных структур с обратной связью (эхо, гребенчатые фильтры)
+type DelayLine struct {
+	buffer []float64 // Кольцевой буфер отсчетов
+	pos    int       // Позиция последнего записанного отсчета
+}
+
+// NewDelayLine создает линию задержки длины length (максимальная задержка length-1).
+// Изначально линия заполнена нулями
+func NewDelayLine(length int) *DelayLine {
+	if length < 1 {
+		panic("DelayLine: length must be at least 1")
+	}
+
+	return &DelayLine{
+		buffer: make([]float64, length),
+		pos:    length - 1, // Первый Push запишет отсчет в позицию 0
+	}
+}
+
+// Push записывает новый отсчет, вытесняя самый старый
+func (d *DelayLine) Push(sample float64) {
+	d.pos++
+	if d.pos == len(d.buffer) {
+		d.pos = 0
+	}
+	d.buffer[d.pos] = sample
+}
+
+// Get возвращает отсчет, записанный delay вызовов Push назад (0 <= delay < Len())
+func (d *DelayLine) Get(delay int) float64 {
+	if delay < 0 || delay >= len(d.buffer) {
+		panic("DelayLine: delay out of range")
+	}
+
+	idx := d.pos - delay
+	if idx < 0 {
+		idx += len(d.buffer)
+	}
+	return d.buffer[idx]
+}
+
+// Dot вычисляет свертку sum(coeffs[i] * Get(i)) за один проход по буферу.
+// Длина coeffs не должна превышать Len()
+func (d *DelayLine) Dot(coeffs []float64) float64 {
+	if len(coeffs) > len(d.buffer) {
+		panic("DelayLine: more coefficients than delay line length")
+	}
+
+	var acc float64
+	idx := d.pos
+	for _, c := range coeffs {
+		acc += c * d.buffer[idx]
+		idx--
+		if idx < 0 {
+			idx = len(d.buffer) - 1
+		}
+	}
+	return acc
+}
+
+// Len возвращает длину линии задержки
+func (d *DelayLine) Len() int {
+	return len(d.buffer)
+}
+
+// Reset заполняет линию задержки нулями
+func (d *DelayLine) Reset() {
+	for i := range d.buffer {
+		d.buffer[i] = 0
+	}
+	d.pos = len(d.buffer) - 1
+}
@@ -0,0 +1,74 @@
+package filters
+
+import "testing"
+
+// TestDelayLine_Wraparound проверяет корректность при записи больше емкости
+func TestDelayLine_Wraparound(t *testing.T) {
+	line := NewDelayLine(4)
+	for i := 1; i <= 10; i++ {
+		line.Push(float64(i))
+	}
+
+	// В линии остались отсчеты 7..10, последний - 10
+	expected := []float64{10, 9, 8, 7}
+	for delay, want := range expected {
+		if got := line.Get(delay); got != want {
+			t.Errorf("Get(%d): ожидалось %f, получено %f", delay, want, got)
+		}
+	}
+	if line.Len() != 4 {
+		t.Errorf("Len: ожидалось 4, получено %d", line.Len())
+	}
+}
+
+// TestDelayLine_Boundaries проверяет задержку 0 и максимальную задержку
+func TestDelayLine_Boundaries(t *testing.T) {
+	line := NewDelayLine(3)
+
+	if got := line.Get(2); got != 0 {
+		t.Errorf("Начальное состояние: ожидалось 0, получено %f", got)
+	}
+
+	line.Push(1)
+	if got := line.Get(0); got != 1 {
+		t.Errorf("Get(0): ожидалось 1, получено %f", got)
+	}
+	if got := line.Get(2); got != 0 {
+		t.Errorf("Get(2) до заполнения: ожидалось 0, получено %f", got)
+	}
+
+	line.Push(2)
+	line.Push(3)
+	if got := line.Get(2); got != 1 {
+		t.Errorf("Get(2): ожидалось 1, получено %f", got)
+	}
+
+	line.Reset()
+	if got := line.Get(0); got != 0 {
+		t.Errorf("После Reset: ожидалось 0, получено %f", got)
+	}
+
+	for _, delay := range []int{-1, 3} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Get(%d): ожидалась паника", delay)
+				}
+			}()
+			line.Get(delay)
+		}()
+	}
+}
+
+// TestDelayLine_Dot проверяет свертку с коэффициентами
+func TestDelayLine_Dot(t *testing.T) {
+	line := NewDelayLine(5)
+	for i := 1; i <= 7; i++ {
+		line.Push(float64(i))
+	}
+
+	// 1*7 + 10*6 + 100*5
+	if got := line.Dot([]float64{1, 10, 100}); got != 567 {
+		t.Errorf("Dot: ожидалось 567, получено %f", got)
+	}
+}
@@ -0,0 +1,43 @@
+package filters
+
+import "math"
+
+// EMA представляет собой экспоненциальное скользящее среднее (интегратор с утечкой):
+// y[n] = alpha*x[n] + (1-alpha)*y[n-1]. Это та же рекурсия, что и в
+// NewFirstOrderLowPassExp, но без накладных расходов IIRFilter.
+// Постоянная времени составляет -1/ln(1-alpha) отсчетов
+type EMA struct {
+	alpha float64 // Коэффициент сглаживания (0 < alpha <= 1)
+	value float64 // Текущее значение среднего
+}
+
+// NewEMA создает экспоненциальное скользящее среднее с коэффициентом alpha.
+// Начальное значение среднего равно нулю
+func NewEMA(alpha float64) *EMA {
+	if alpha <= 0 || alpha > 1 || math.IsNaN(alpha) {
+		panic("EMA: alpha must be in range (0, 1]")
+	}
+
+	return &EMA{alpha: alpha}
+}
+
+// Tick обновляет среднее новым отсчетом и возвращает его значение
+func (e *EMA) Tick(input float64) float64 {
+	e.value += e.alpha * (input - e.value)
+	return e.value
+}
+
+// Value возвращает текущее значение среднего
+func (e *EMA) Value() float64 {
+	return e.value
+}
+
+// Reset сбрасывает среднее в ноль
+func (e *EMA) Reset() {
+	e.value = 0
+}
+
+// GetAlpha возвращает коэффициент сглаживания
+func (e *EMA) GetAlpha() float64 {
+	return e.alpha
+}
@@ -0,0 +1,66 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestEMA_StepResponse проверяет постоянную времени переходной характеристики
+func TestEMA_StepResponse(t *testing.T) {
+	const alpha = 0.01
+	ema := NewEMA(alpha)
+	tau := -1 / math.Log(1-alpha) // ~99.5 отсчетов
+
+	var got float64
+	for n := 1; n <= 500; n++ {
+		got = ema.Tick(5)
+
+		// y[n] = 5 * (1 - (1-alpha)^n)
+		expected := 5 * (1 - math.Pow(1-alpha, float64(n)))
+		if math.Abs(got-expected) > 1e-12 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", n, expected, got)
+		}
+		if n == int(math.Round(tau)) && math.Abs(got/5-(1-1/math.E)) > 0.01 {
+			t.Errorf("За постоянную времени достигнуто %.3f от конечного значения, ожидалось 0.632", got/5)
+		}
+	}
+
+	if got != ema.Value() {
+		t.Errorf("Value() = %f, последний Tick = %f", ema.Value(), got)
+	}
+
+	// Совпадение с экспоненциальным ФНЧ из iir.go
+	ema.Reset()
+	iir := NewFirstOrderLowPassExp(0.05)
+	emaEquivalent := NewEMA(1 - math.Exp(-2*math.Pi*0.05))
+	for i := 0; i < 50; i++ {
+		x := math.Sin(0.3 * float64(i))
+		if a, b := emaEquivalent.Tick(x), iir.Tick(x); math.Abs(a-b) > 1e-12 {
+			t.Fatalf("Отсчет %d: EMA %f, NewFirstOrderLowPassExp %f", i, a, b)
+		}
+	}
+}
+
+// TestEMA_AlphaOne проверяет, что alpha = 1 пропускает вход без изменений
+func TestEMA_AlphaOne(t *testing.T) {
+	ema := NewEMA(1)
+	for _, x := range []float64{3, -1, 0.5, 100} {
+		if got := ema.Tick(x); got != x {
+			t.Errorf("Tick(%f) = %f", x, got)
+		}
+	}
+}
+
+// TestEMA_InvalidAlpha проверяет панику при недопустимом alpha
+func TestEMA_InvalidAlpha(t *testing.T) {
+	for _, alpha := range []float64{0, -0.5, 1.01, math.NaN()} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("alpha = %v: ожидалась паника", alpha)
+				}
+			}()
+			_ = NewEMA(alpha)
+		}()
+	}
+}
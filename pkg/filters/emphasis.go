@@ -0,0 +1,26 @@
+package filters
+
+import "math"
+
+// NewPreEmphasis создает фильтр предыскажения первого порядка
+// y[n] = x[n] - coeff*x[n-1], поднимающий верхние частоты (для речи обычно
+// coeff ≈ 0.97). Обратный фильтр создается NewDeEmphasis с тем же coeff
+// coeff: коэффициент предыскажения (|coeff| < 1)
+func NewPreEmphasis(coeff float64) *FIRFilter {
+	if math.Abs(coeff) >= 1 {
+		panic("FIRFilter: pre-emphasis coefficient magnitude must be less than 1")
+	}
+	return NewFIRFilter([]float64{1, -coeff})
+}
+
+// NewDeEmphasis создает фильтр компенсации предыскажения
+// y[n] = x[n] + coeff*y[n-1], H(z) = 1 / (1 - coeff*z^-1) - точную обратную
+// характеристику NewPreEmphasis. Последовательное соединение двух фильтров
+// с одинаковым coeff из нулевого состояния восстанавливает исходный сигнал
+// coeff: коэффициент предыскажения (|coeff| < 1)
+func NewDeEmphasis(coeff float64) *IIRFilter {
+	if math.Abs(coeff) >= 1 {
+		panic("IIRFilter: de-emphasis coefficient magnitude must be less than 1")
+	}
+	return NewIIRFilter([]float64{1}, []float64{1, -coeff})
+}
@@ -0,0 +1,61 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// TestEmphasis_RoundTrip проверяет восстановление сигнала после предыскажения и компенсации
+func TestEmphasis_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	input := make([]float64, 4000)
+	for i := range input {
+		input[i] = math.Sin(0.01*float64(i)) + 0.2*rng.NormFloat64()
+	}
+
+	for _, coeff := range []float64{0.9, 0.95, 0.97} {
+		pre := NewPreEmphasis(coeff)
+		de := NewDeEmphasis(coeff)
+
+		output := de.Process(pre.Process(input))
+		for i := range input {
+			if math.Abs(output[i]-input[i]) > 1e-9 {
+				t.Fatalf("coeff=%.2f, отсчет %d: ожидалось %f, получено %f", coeff, i, input[i], output[i])
+			}
+		}
+	}
+}
+
+// TestPreEmphasis_HighFrequencyBoost проверяет подъем верхних частот
+func TestPreEmphasis_HighFrequencyBoost(t *testing.T) {
+	const coeff = 0.97
+	pre := NewPreEmphasis(coeff)
+
+	// |H(0)| = 1 - coeff, |H(fs/2)| = 1 + coeff
+	if dc := cmplx.Abs(pre.GetFrequencyResponse(0)); math.Abs(dc-(1-coeff)) > 1e-12 {
+		t.Errorf("Усиление на нулевой частоте: ожидалось %f, получено %f", 1-coeff, dc)
+	}
+	if ny := cmplx.Abs(pre.GetFrequencyResponse(0.5)); math.Abs(ny-(1+coeff)) > 1e-12 {
+		t.Errorf("Усиление на частоте Найквиста: ожидалось %f, получено %f", 1+coeff, ny)
+	}
+}
+
+// TestEmphasis_InvalidParams проверяет панику при неустойчивом коэффициенте
+func TestEmphasis_InvalidParams(t *testing.T) {
+	for _, fn := range []func(){
+		func() { NewPreEmphasis(1) },
+		func() { NewDeEmphasis(1) },
+		func() { NewDeEmphasis(-1.5) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			fn()
+		}()
+	}
+}
@@ -0,0 +1,52 @@
+package filters
+
+import "dsp_go/pkg/fft"
+
+// FastConvolve вычисляет полную линейную свертку методом перекрытия со сложением
+// (overlap-add) с использованием БПФ. Результат совпадает с Convolve с точностью
+// до погрешности округления, но для длинных ядер работает за O(N·log M)
+func FastConvolve(signal, kernel []float64) []float64 {
+	if len(signal) == 0 || len(kernel) == 0 {
+		return []float64{}
+	}
+
+	// Размер БПФ - степень двойки не меньше удвоенной длины ядра,
+	// чтобы на каждый блок приходилось не меньше len(kernel) новых отсчетов
+	fftSize := fft.NextPowerOfTwo(2 * len(kernel))
+	blockLen := fftSize - len(kernel) + 1
+
+	paddedKernel := make([]complex128, fftSize)
+	for i, k := range kernel {
+		paddedKernel[i] = complex(k, 0)
+	}
+	kernelSpectrum := fft.FFT(paddedKernel)
+
+	output := make([]float64, len(signal)+len(kernel)-1)
+	block := make([]complex128, fftSize)
+	for start := 0; start < len(signal); start += blockLen {
+		end := start + blockLen
+		if end > len(signal) {
+			end = len(signal)
+		}
+
+		for i := range block {
+			block[i] = 0
+		}
+		for i, s := range signal[start:end] {
+			block[i] = complex(s, 0)
+		}
+
+		spectrum := fft.FFT(block)
+		for i := range spectrum {
+			spectrum[i] *= kernelSpectrum[i]
+		}
+		result := fft.IFFT(spectrum)
+
+		// Складываем хвост блока с началом следующего
+		for i := 0; i < fftSize && start+i < len(output); i++ {
+			output[start+i] += real(result[i])
+		}
+	}
+
+	return output
+}
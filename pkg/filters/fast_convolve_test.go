@@ -0,0 +1,83 @@
+package filters
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestFastConvolveMatchesDirect проверяет совпадение быстрой и прямой свертки
+func TestFastConvolveMatchesDirect(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name      string
+		signalLen int
+		kernelLen int
+	}{
+		{"Короткое ядро", 1000, 7},
+		{"Длинное ядро", 3000, 257},
+		{"Ядро длиннее сигнала", 50, 300},
+		{"Единичное ядро", 100, 1},
+		{"Неполный последний блок", 1001, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal := make([]float64, tt.signalLen)
+			for i := range signal {
+				signal[i] = rng.Float64()*2 - 1
+			}
+			kernel := make([]float64, tt.kernelLen)
+			for i := range kernel {
+				kernel[i] = rng.Float64()*2 - 1
+			}
+
+			direct := Convolve(signal, kernel)
+			fast := FastConvolve(signal, kernel)
+			if len(fast) != len(direct) {
+				t.Fatalf("Длина: ожидалось %d, получено %d", len(direct), len(fast))
+			}
+			for i := range direct {
+				if math.Abs(fast[i]-direct[i]) > 1e-9 {
+					t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, direct[i], fast[i])
+				}
+			}
+		})
+	}
+
+	if got := FastConvolve(nil, []float64{1}); got == nil || len(got) != 0 {
+		t.Errorf("Пустой сигнал: ожидался пустой срез, получено %v", got)
+	}
+}
+
+// convolveBenchmarkData возвращает сигнал из 65536 отсчетов и ядро из 1024 коэффициентов
+func convolveBenchmarkData() ([]float64, []float64) {
+	signal := make([]float64, 65536)
+	for i := range signal {
+		signal[i] = math.Sin(0.01 * float64(i))
+	}
+	kernel := make([]float64, 1024)
+	for i := range kernel {
+		kernel[i] = 1.0 / float64(len(kernel))
+	}
+	return signal, kernel
+}
+
+// BenchmarkConvolve измеряет производительность прямой свертки
+func BenchmarkConvolve(b *testing.B) {
+	signal, kernel := convolveBenchmarkData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Convolve(signal, kernel)
+	}
+}
+
+// BenchmarkFastConvolve измеряет производительность свертки через БПФ
+func BenchmarkFastConvolve(b *testing.B) {
+	signal, kernel := convolveBenchmarkData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FastConvolve(signal, kernel)
+	}
+}
@@ -0,0 +1,19 @@
+package filters
+
+// Filter - общий интерфейс фильтров с поотсчетной и блочной обработкой.
+// Позволяет строить цепочки обработки из фильтров разных типов
+type Filter interface {
+	TickProcessor
+	Process(input []float64) []float64
+}
+
+// Проверка на этапе компиляции
+var (
+	_ Filter = (*FIRFilter)(nil)
+	_ Filter = (*IIRFilter)(nil)
+	_ Filter = (*BiquadCascade)(nil)
+	_ Filter = (*ParallelBank)(nil)
+	_ Filter = (*MovingAverage)(nil)
+	_ Filter = (*MedianFilter)(nil)
+	_ Filter = (*FractionalDelay)(nil)
+)
@@ -0,0 +1,55 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestFilter_MixedChain проверяет цепочку из фильтров разных типов
+func TestFilter_MixedChain(t *testing.T) {
+	newChain := func() []Filter {
+		return []Filter{
+			NewFIRFilter([]float64{0.25, 0.5, 0.25}),
+			NewFirstOrderLowPass(0.2),
+			NewMovingAverage(4),
+			NewMedianFilter(3),
+		}
+	}
+
+	input := make([]float64, 300)
+	for i := range input {
+		input[i] = math.Sin(0.07*float64(i)) + 0.2*math.Cos(2.1*float64(i))
+	}
+
+	// Блочная обработка цепочки
+	chain := newChain()
+	blockOut := input
+	for _, f := range chain {
+		blockOut = f.Process(blockOut)
+	}
+
+	// Поотсчетная обработка свежей цепочки должна совпасть
+	tickChain := newChain()
+	for i, val := range input {
+		for _, f := range tickChain {
+			val = f.Tick(val)
+		}
+		if math.Abs(val-blockOut[i]) > 1e-12 {
+			t.Fatalf("Отсчет %d: Process %f, Tick %f", i, blockOut[i], val)
+		}
+	}
+
+	// После Reset цепочка дает тот же результат
+	for _, f := range chain {
+		f.Reset()
+	}
+	again := input
+	for _, f := range chain {
+		again = f.Process(again)
+	}
+	for i := range again {
+		if again[i] != blockOut[i] {
+			t.Fatalf("Отсчет %d после Reset: ожидалось %f, получено %f", i, blockOut[i], again[i])
+		}
+	}
+}
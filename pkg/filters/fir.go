@@ -1,10 +1,15 @@
 package filters
 
+import (
+	"math"
+	"math/cmplx"
+)
+
 // FIRFilter представляет собой структуру КИХ-фильтра
 type FIRFilter struct {
-	coeffs []float64 // Коэффициенты фильтра
-	buffer []float64 // Кольцевой буфер задержанных отсчетов сигнала
-	pos    int       // Текущая позиция в буфере
+	coeffs   []float64  // Коэффициенты фильтра
+	line     *DelayLine // Линия задержки отсчетов сигнала
+	sanitize bool       // Замена NaN/Inf на входе нулем
 }
 
 // NewFIRFilter создает новый экземпляр фильтра, принимая массив коэффициентов
@@ -13,45 +18,46 @@ func NewFIRFilter(coeffs []float64) *FIRFilter {
 		panic("FIRFilter: coefficients cannot be empty")
 	}
 
-	n := len(coeffs)
 	return &FIRFilter{
 		coeffs: coeffs,
-		buffer: make([]float64, n),
-		pos:    n - 1, // pos указывает на позицию для нового элемента
+		line:   NewDelayLine(len(coeffs)),
 	}
 }
 
 // Tick применяет фильтр к одному новому отсчету
 func (f *FIRFilter) Tick(input float64) float64 {
-	// Перемещаем позицию и записываем новый отсчет
-	f.pos = (f.pos + 1) % len(f.buffer)
-	f.buffer[f.pos] = input
-
-	// Вычисляем свертку
-	var output float64
-	coeffIdx := 0
-	bufIdx := f.pos
-
-	for coeffIdx < len(f.coeffs) {
-		output += f.coeffs[coeffIdx] * f.buffer[bufIdx]
-		coeffIdx++
-
-		// Двигаемся назад по буферу
-		bufIdx--
-		if bufIdx < 0 {
-			bufIdx = len(f.buffer) - 1
-		}
+	if f.sanitize {
+		input = finiteOrZero(input)
 	}
+	f.line.Push(input)
+	return f.line.Dot(f.coeffs)
+}
 
+// Process обрабатывает весь срез входных данных.
+// Состояние фильтра сохраняется между вызовами, поэтому последовательные
+// блоки сшиваются без разрывов; результат совпадает с циклом вызовов Tick
+func (f *FIRFilter) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		if f.sanitize {
+			val = finiteOrZero(val)
+		}
+		f.line.Push(val)
+		output[i] = f.line.Dot(f.coeffs)
+	}
 	return output
 }
 
 // Reset сбрасывает состояние фильтра (очищает буфер)
 func (f *FIRFilter) Reset() {
-	for i := range f.buffer {
-		f.buffer[i] = 0
-	}
-	f.pos = len(f.buffer) - 1
+	f.line.Reset()
+}
+
+// SetSanitize включает режим очистки входа: отсчеты NaN и ±Inf заменяются
+// нулем до записи в буфер, поэтому один испорченный отсчет влияет только на
+// len(coeffs) выходных отсчетов, а не на весь последующий сигнал
+func (f *FIRFilter) SetSanitize(enabled bool) {
+	f.sanitize = enabled
 }
 
 // GetCoefficients возвращает копию коэффициентов фильтра
@@ -63,5 +69,53 @@ func (f *FIRFilter) GetCoefficients() []float64 {
 
 // GetBufferSize возвращает размер буфера фильтра
 func (f *FIRFilter) GetBufferSize() int {
-	return len(f.buffer)
+	return f.line.Len()
+}
+
+// GetFrequencyResponse вычисляет частотную характеристику на заданной частоте
+// H(z) = sum(coeffs[i] * z^-i), где z = e^(j*2*pi*freq)
+func (f *FIRFilter) GetFrequencyResponse(freq float64) complex128 {
+	if freq < 0 || freq > 0.5 {
+		panic("frequency must be between 0 and 0.5 (Nyquist)")
+	}
+
+	// Вычисляем z^-1 = e^(-j*2*pi*freq)
+	omega := 2.0 * math.Pi * freq
+	zInv := complex(math.Cos(omega), -math.Sin(omega))
+
+	var sum complex128
+	zPower := complex(1, 0)
+	for _, c := range f.coeffs {
+		sum += complex(c, 0) * zPower
+		zPower *= zInv
+	}
+
+	return sum
+}
+
+// GetGroupDelay вычисляет групповую задержку (в отсчетах) на заданной частоте
+func (f *FIRFilter) GetGroupDelay(freq float64) float64 {
+	if freq < 0 || freq > 0.5 {
+		panic("frequency must be between 0 and 0.5 (Nyquist)")
+	}
+
+	// Аналитическое вычисление производной фазы:
+	// H(w) = sum(c[i] * e^(-jwi)), dH/dw = -j * sum(i * c[i] * e^(-jwi))
+	// Групповая задержка = -d(phase)/dw = Re[sum(i * c[i] * e^(-jwi)) / H(w)]
+	omega := 2.0 * math.Pi * freq
+	zInv := complex(math.Cos(omega), -math.Sin(omega))
+
+	var hSum, weightedSum complex128
+	zPower := complex(1, 0)
+	for i, c := range f.coeffs {
+		hSum += complex(c, 0) * zPower
+		weightedSum += complex(c*float64(i), 0) * zPower
+		zPower *= zInv
+	}
+
+	if cmplx.Abs(hSum) < 1e-12 {
+		return 0 // Избегаем деления на ноль
+	}
+
+	return real(weightedSum / hSum)
 }
@@ -0,0 +1,18 @@
+package filters
+
+// Cascade объединяет последовательно включенные КИХ-фильтры в один эквивалентный.
+// Коэффициенты результата - свертка коэффициентов всех звеньев, поэтому при
+// обработке выполняется одна свертка вместо нескольких. Длина результата
+// равна sum(len(coeffs_i)) - (n - 1). Состояние исходных фильтров не копируется
+func Cascade(filters ...*FIRFilter) *FIRFilter {
+	if len(filters) == 0 {
+		panic("Cascade: at least one filter is required")
+	}
+
+	coeffs := filters[0].GetCoefficients()
+	for _, f := range filters[1:] {
+		coeffs = Convolve(coeffs, f.coeffs)
+	}
+
+	return NewFIRFilter(coeffs)
+}
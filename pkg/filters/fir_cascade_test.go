@@ -0,0 +1,68 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestCascade_Coefficients проверяет свертку коэффициентов звеньев
+func TestCascade_Coefficients(t *testing.T) {
+	cascade := Cascade(NewFIRFilter([]float64{1, 1}), NewFIRFilter([]float64{1, -1}))
+
+	expected := []float64{1, 0, -1}
+	coeffs := cascade.GetCoefficients()
+	if len(coeffs) != len(expected) {
+		t.Fatalf("Длина: ожидалось %d, получено %d", len(expected), len(coeffs))
+	}
+	for i := range expected {
+		if math.Abs(coeffs[i]-expected[i]) > 1e-12 {
+			t.Errorf("Коэффициент %d: ожидалось %f, получено %f", i, expected[i], coeffs[i])
+		}
+	}
+}
+
+// TestCascade_MatchesSequential проверяет совпадение с последовательной обработкой
+func TestCascade_MatchesSequential(t *testing.T) {
+	stages := []*FIRFilter{
+		NewFIRFilter([]float64{1, 1}),
+		NewFIRFilter([]float64{1, -1}),
+		NewFIRFilter([]float64{0.25, 0.5, 0.25}),
+	}
+	cascade := Cascade(stages...)
+
+	for i := 0; i < 200; i++ {
+		input := math.Sin(0.1*float64(i)) + 0.5*math.Cos(0.7*float64(i))
+
+		want := input
+		for _, stage := range stages {
+			want = stage.Tick(want)
+		}
+
+		if got := cascade.Tick(input); math.Abs(got-want) > 1e-12 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, want, got)
+		}
+	}
+}
+
+// TestCascade_Single проверяет, что одно звено копируется без изменений
+func TestCascade_Single(t *testing.T) {
+	coeffs := []float64{0.2, 0.3, 0.5}
+	cascade := Cascade(NewFIRFilter(coeffs))
+
+	got := cascade.GetCoefficients()
+	for i := range coeffs {
+		if got[i] != coeffs[i] {
+			t.Errorf("Коэффициент %d: ожидалось %f, получено %f", i, coeffs[i], got[i])
+		}
+	}
+}
+
+// TestCascade_Empty проверяет панику без звеньев
+func TestCascade_Empty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника для пустого каскада")
+		}
+	}()
+	Cascade()
+}
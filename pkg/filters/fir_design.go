@@ -0,0 +1,108 @@
+package filters
+
+import (
+	"math"
+
+	"dsp_go/pkg/windows"
+)
+
+// DesignLowPassFIR рассчитывает коэффициенты КИХ-фильтра нижних частот
+// методом взвешенного sinc
+// cutoff: нормированная частота среза (0 < cutoff < 0.5, где 0.5 - частота Найквиста)
+// numTaps: количество коэффициентов (нечетное для линейной фазы)
+// window: оконная функция (nil - окно Хэмминга)
+func DesignLowPassFIR(cutoff float64, numTaps int, window windows.WindowFunc) []float64 {
+	if cutoff <= 0 || cutoff >= 0.5 {
+		panic("FIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if numTaps <= 0 || numTaps%2 == 0 {
+		panic("FIRFilter: number of taps must be positive and odd")
+	}
+
+	if window == nil {
+		window = windows.Get(windows.Hamming)
+	}
+
+	coeffs := sincKernel(cutoff, numTaps)
+	w := window(numTaps)
+	for i := range coeffs {
+		coeffs[i] *= w[i]
+	}
+
+	// Нормируем коэффициент передачи на нулевой частоте к 1
+	return NormalizeDCGain(coeffs)
+}
+
+// DesignHighPassFIR рассчитывает коэффициенты КИХ-фильтра верхних частот
+// методом спектральной инверсии ФНЧ-прототипа
+// cutoff: нормированная частота среза (0 < cutoff < 0.5)
+// numTaps: количество коэффициентов (нечетное для линейной фазы)
+func DesignHighPassFIR(cutoff float64, numTaps int) []float64 {
+	// Прототип уже нормирован к единичному усилению на нулевой частоте
+	coeffs := DesignLowPassFIR(cutoff, numTaps, nil)
+
+	// Спектральная инверсия: h_hp[n] = delta[n - center] - h_lp[n]
+	for i := range coeffs {
+		coeffs[i] = -coeffs[i]
+	}
+	coeffs[(numTaps-1)/2] += 1.0
+
+	return coeffs
+}
+
+// DesignBandPassFIR рассчитывает коэффициенты полосового КИХ-фильтра
+// как разность двух sinc-ядер ФНЧ (окно Хэмминга)
+// lowCut, highCut: нормированные границы полосы пропускания (0 < lowCut < highCut < 0.5)
+// numTaps: количество коэффициентов (нечетное для линейной фазы)
+func DesignBandPassFIR(lowCut, highCut float64, numTaps int) []float64 {
+	if lowCut <= 0 || lowCut >= 0.5 || highCut <= 0 || highCut >= 0.5 {
+		panic("FIRFilter: cutoff frequencies must be between 0 and 0.5")
+	}
+	if lowCut >= highCut {
+		panic("FIRFilter: low cutoff must be less than high cutoff")
+	}
+	if numTaps <= 0 || numTaps%2 == 0 {
+		panic("FIRFilter: number of taps must be positive and odd")
+	}
+
+	high := sincKernel(highCut, numTaps)
+	low := sincKernel(lowCut, numTaps)
+	window := windows.Get(windows.Hamming)(numTaps)
+
+	coeffs := make([]float64, numTaps)
+	for i := range coeffs {
+		coeffs[i] = (high[i] - low[i]) * window[i]
+	}
+
+	// Нормируем коэффициент передачи в центре полосы пропускания к 1
+	center := (lowCut + highCut) / 2
+	omega := 2.0 * math.Pi * center
+	var re, im float64
+	for n, c := range coeffs {
+		re += c * math.Cos(omega*float64(n))
+		im -= c * math.Sin(omega*float64(n))
+	}
+	gain := math.Hypot(re, im)
+	for i := range coeffs {
+		coeffs[i] /= gain
+	}
+
+	return coeffs
+}
+
+// sincKernel вычисляет идеальную импульсную характеристику ФНЧ,
+// центрированную в точке (numTaps-1)/2
+func sincKernel(cutoff float64, numTaps int) []float64 {
+	kernel := make([]float64, numTaps)
+	center := float64(numTaps-1) / 2
+
+	for n := 0; n < numTaps; n++ {
+		x := float64(n) - center
+		if x == 0 {
+			kernel[n] = 2 * cutoff
+		} else {
+			kernel[n] = math.Sin(2*math.Pi*cutoff*x) / (math.Pi * x)
+		}
+	}
+	return kernel
+}
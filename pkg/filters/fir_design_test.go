@@ -0,0 +1,190 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"dsp_go/pkg/windows"
+)
+
+// TestDesignLowPassFIR проверяет расчет ФНЧ методом взвешенного sinc
+func TestDesignLowPassFIR(t *testing.T) {
+	tests := []struct {
+		name    string
+		cutoff  float64
+		numTaps int
+	}{
+		{name: "fc=0.1, 31 коэффициент", cutoff: 0.1, numTaps: 31},
+		{name: "fc=0.25, 63 коэффициента", cutoff: 0.25, numTaps: 63},
+		{name: "fc=0.05, 101 коэффициент", cutoff: 0.05, numTaps: 101},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coeffs := DesignLowPassFIR(tt.cutoff, tt.numTaps, nil)
+
+			if len(coeffs) != tt.numTaps {
+				t.Fatalf("Ожидалось %d коэффициентов, получено %d", tt.numTaps, len(coeffs))
+			}
+
+			// Симметрия коэффициентов
+			for i := 0; i < len(coeffs)/2; i++ {
+				j := len(coeffs) - 1 - i
+				if math.Abs(coeffs[i]-coeffs[j]) > 1e-12 {
+					t.Errorf("Нарушена симметрия: h[%d]=%e, h[%d]=%e", i, coeffs[i], j, coeffs[j])
+				}
+			}
+
+			filter := NewFIRFilter(coeffs)
+
+			// Коэффициент передачи на нулевой частоте
+			if dc := cmplx.Abs(filter.GetFrequencyResponse(0)); math.Abs(dc-1.0) > 1e-12 {
+				t.Errorf("Коэффициент передачи на DC: ожидалось 1, получено %f", dc)
+			}
+
+			// Коэффициент передачи на частоте Найквиста
+			if nyq := cmplx.Abs(filter.GetFrequencyResponse(0.5)); nyq > 1e-2 {
+				t.Errorf("Коэффициент передачи на Найквисте: ожидалось ~0, получено %f", nyq)
+			}
+		})
+	}
+}
+
+// TestDesignLowPassFIRWindows проверяет расчет ФНЧ с разными окнами
+func TestDesignLowPassFIRWindows(t *testing.T) {
+	// Минимальное подавление в полосе задерживания (дБ) для каждого окна
+	tests := []struct {
+		window        windows.Window
+		minStopbandDB float64
+	}{
+		{window: windows.Rectangular, minStopbandDB: 18},
+		{window: windows.Hann, minStopbandDB: 40},
+		{window: windows.Hamming, minStopbandDB: 50},
+		{window: windows.Blackman, minStopbandDB: 70},
+		{window: windows.BlackmanHarris, minStopbandDB: 90},
+	}
+
+	cutoff, numTaps := 0.1, 101
+	for _, tt := range tests {
+		t.Run(tt.window.String(), func(t *testing.T) {
+			coeffs := DesignLowPassFIR(cutoff, numTaps, windows.Get(tt.window))
+			filter := NewFIRFilter(coeffs)
+
+			if dc := cmplx.Abs(filter.GetFrequencyResponse(0)); math.Abs(dc-1.0) > 1e-12 {
+				t.Errorf("Коэффициент передачи на DC: ожидалось 1, получено %f", dc)
+			}
+
+			// Проверяем подавление вдали от частоты среза
+			for freq := 0.2; freq <= 0.5; freq += 0.01 {
+				gainDB := 20 * math.Log10(cmplx.Abs(filter.GetFrequencyResponse(freq)))
+				if gainDB > -tt.minStopbandDB {
+					t.Errorf("Частота %.2f: ожидалось подавление не менее %.0f дБ, получено %.1f дБ",
+						freq, tt.minStopbandDB, gainDB)
+					break
+				}
+			}
+		})
+	}
+
+	// Окно по умолчанию - Хэмминга
+	defaultCoeffs := DesignLowPassFIR(cutoff, numTaps, nil)
+	hammingCoeffs := DesignLowPassFIR(cutoff, numTaps, windows.Get(windows.Hamming))
+	for i := range defaultCoeffs {
+		if defaultCoeffs[i] != hammingCoeffs[i] {
+			t.Fatalf("Окно по умолчанию должно совпадать с окном Хэмминга (коэффициент %d)", i)
+		}
+	}
+}
+
+// TestDesignLowPassFIRInvalidParams проверяет панику при неверных параметрах
+func TestDesignLowPassFIRInvalidParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		cutoff  float64
+		numTaps int
+	}{
+		{name: "нулевая частота среза", cutoff: 0, numTaps: 31},
+		{name: "частота среза на Найквисте", cutoff: 0.5, numTaps: 31},
+		{name: "четное число коэффициентов", cutoff: 0.1, numTaps: 32},
+		{name: "нулевое число коэффициентов", cutoff: 0.1, numTaps: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			DesignLowPassFIR(tt.cutoff, tt.numTaps, nil)
+		})
+	}
+}
+
+// TestDesignHighPassFIR проверяет расчет ФВЧ методом спектральной инверсии
+func TestDesignHighPassFIR(t *testing.T) {
+	for _, cutoff := range []float64{0.1, 0.2, 0.35} {
+		coeffs := DesignHighPassFIR(cutoff, 51)
+		filter := NewFIRFilter(coeffs)
+
+		if dc := cmplx.Abs(filter.GetFrequencyResponse(0)); dc > 1e-12 {
+			t.Errorf("fc=%.2f: коэффициент передачи на DC: ожидалось ~0, получено %e", cutoff, dc)
+		}
+		if nyq := cmplx.Abs(filter.GetFrequencyResponse(0.5)); math.Abs(nyq-1.0) > 1e-2 {
+			t.Errorf("fc=%.2f: коэффициент передачи на Найквисте: ожидалось ~1, получено %f", cutoff, nyq)
+		}
+
+		// Симметрия коэффициентов сохраняется
+		for i := 0; i < len(coeffs)/2; i++ {
+			if math.Abs(coeffs[i]-coeffs[len(coeffs)-1-i]) > 1e-12 {
+				t.Errorf("fc=%.2f: нарушена симметрия в позиции %d", cutoff, i)
+			}
+		}
+	}
+}
+
+// TestDesignBandPassFIR проверяет расчет полосового фильтра
+func TestDesignBandPassFIR(t *testing.T) {
+	lowCut, highCut := 0.15, 0.3
+	filter := NewFIRFilter(DesignBandPassFIR(lowCut, highCut, 101))
+
+	center := (lowCut + highCut) / 2
+	if gain := cmplx.Abs(filter.GetFrequencyResponse(center)); math.Abs(gain-1.0) > 1e-12 {
+		t.Errorf("Коэффициент передачи в центре полосы: ожидалось 1, получено %f", gain)
+	}
+
+	// Обе полосы задерживания должны сильно ослабляться
+	for _, freq := range []float64{0, 0.05, 0.1, 0.35, 0.4, 0.5} {
+		gainDB := 20 * math.Log10(cmplx.Abs(filter.GetFrequencyResponse(freq)))
+		if gainDB > -40 {
+			t.Errorf("Частота %.2f: ожидалось подавление не менее 40 дБ, получено %.1f дБ", freq, gainDB)
+		}
+	}
+}
+
+// TestDesignBandPassFIRInvalidParams проверяет панику при неверных параметрах
+func TestDesignBandPassFIRInvalidParams(t *testing.T) {
+	tests := []struct {
+		name            string
+		lowCut, highCut float64
+		numTaps         int
+	}{
+		{name: "нижняя граница больше верхней", lowCut: 0.3, highCut: 0.1, numTaps: 31},
+		{name: "равные границы", lowCut: 0.2, highCut: 0.2, numTaps: 31},
+		{name: "верхняя граница на Найквисте", lowCut: 0.1, highCut: 0.5, numTaps: 31},
+		{name: "нулевая нижняя граница", lowCut: 0, highCut: 0.2, numTaps: 31},
+		{name: "четное число коэффициентов", lowCut: 0.1, highCut: 0.2, numTaps: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			DesignBandPassFIR(tt.lowCut, tt.highCut, tt.numTaps)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package filters
+
+import "dsp_go/pkg/windows"
+
+// DesignDifferentiatorFIR рассчитывает коэффициенты КИХ-дифференциатора
+// (окно Блэкмана). Идеальная характеристика h[m] = (-1)^m / m, h[0] = 0
+// (m - смещение от центра) антисимметрична и соответствует H(ω) = jω.
+// Коэффициенты нормированы к единичному наклону АЧХ на нулевой частоте:
+// линейно нарастающий сигнал с шагом 1 на отсчет дает на выходе 1.
+// Групповая задержка постоянна и равна (numTaps-1)/2.
+// Окно обнуляет оба крайних коэффициента, поэтому фактическая длина ядра
+// равна numTaps-2
+// numTaps: количество коэффициентов (нечетное, не меньше 5)
+func DesignDifferentiatorFIR(numTaps int) []float64 {
+	if numTaps < 5 || numTaps%2 == 0 {
+		panic("FIRFilter: differentiator needs an odd number of taps, at least 5")
+	}
+
+	coeffs := make([]float64, numTaps)
+	center := (numTaps - 1) / 2
+	window := windows.Get(windows.Blackman)(numTaps)
+
+	// Наклон АЧХ на нулевой частоте: dH/d(jω) = -sum(m * h[m])
+	var slope float64
+	for n := range coeffs {
+		m := n - center
+		if m == 0 {
+			continue
+		}
+		sign := 1.0
+		if m%2 != 0 {
+			sign = -1
+		}
+		coeffs[n] = sign / float64(m) * window[n]
+		slope -= float64(m) * coeffs[n]
+	}
+
+	return scaleCoeffs(coeffs, 1/slope)
+}
@@ -0,0 +1,63 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDesignDifferentiatorFIR_Ramp проверяет, что производная линейного сигнала постоянна
+func TestDesignDifferentiatorFIR_Ramp(t *testing.T) {
+	for _, numTaps := range []int{5, 15, 31} {
+		fir := NewFIRFilter(DesignDifferentiatorFIR(numTaps))
+
+		for n := 0; n < 200; n++ {
+			y := fir.Tick(0.5*float64(n) + 3)
+			if n >= numTaps && math.Abs(y-0.5) > 1e-12 {
+				t.Fatalf("numTaps=%d, отсчет %d: ожидалось 0.5, получено %f", numTaps, n, y)
+			}
+		}
+	}
+}
+
+// TestDesignDifferentiatorFIR_LinearMagnitude проверяет рост АЧХ, пропорциональный частоте
+func TestDesignDifferentiatorFIR_LinearMagnitude(t *testing.T) {
+	coeffs := DesignDifferentiatorFIR(31)
+	center := (len(coeffs) - 1) / 2
+
+	for n, c := range coeffs {
+		if math.Abs(c+coeffs[len(coeffs)-1-n]) > 1e-15 {
+			t.Errorf("Коэффициенты %d и %d не антисимметричны", n, len(coeffs)-1-n)
+		}
+	}
+	if coeffs[center] != 0 {
+		t.Errorf("Центральный коэффициент: ожидался 0, получено %g", coeffs[center])
+	}
+
+	// В нижней части полосы |H(ω)| ≈ ω
+	for _, freq := range []float64{0.01, 0.05, 0.1, 0.2, 0.3} {
+		omega := 2 * math.Pi * freq
+		var re, im float64
+		for n, c := range coeffs {
+			re += c * math.Cos(omega*float64(n))
+			im -= c * math.Sin(omega*float64(n))
+		}
+		mag := math.Hypot(re, im)
+		if math.Abs(mag-omega)/omega > 0.01 {
+			t.Errorf("f=%.2f: ожидалось |H| = %f, получено %f", freq, omega, mag)
+		}
+	}
+}
+
+// TestDesignDifferentiatorFIR_InvalidParams проверяет панику при неверной длине
+func TestDesignDifferentiatorFIR_InvalidParams(t *testing.T) {
+	for _, numTaps := range []int{0, 3, 8} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("numTaps=%d: ожидалась паника", numTaps)
+				}
+			}()
+			DesignDifferentiatorFIR(numTaps)
+		}()
+	}
+}
@@ -0,0 +1,42 @@
+package filters
+
+import (
+	"math"
+
+	"dsp_go/pkg/windows"
+)
+
+// DesignHilbertFIR рассчитывает коэффициенты КИХ-преобразователя Гильберта
+// (окно Хэмминга). Идеальная характеристика h[m] = 2/(π·m) для нечетных m
+// и 0 для четных (m - смещение от центра) антисимметрична, поэтому фильтр
+// сдвигает фазу на -90° при постоянной групповой задержке (numTaps-1)/2:
+// cos(ωn) переходит в sin(ω(n-D)). Коэффициент передачи близок к 1 в средней
+// части полосы и спадает к нулевой частоте и частоте Найквиста.
+//
+// Для формирования квадратур в реальном времени квадратурный канал
+// фильтруется NewFIRFilter(DesignHilbertFIR(numTaps)), а синфазный
+// задерживается на HilbertDelay(numTaps) отсчетов.
+// numTaps: количество коэффициентов (нечетное, не меньше 3)
+func DesignHilbertFIR(numTaps int) []float64 {
+	if numTaps < 3 || numTaps%2 == 0 {
+		panic("FIRFilter: Hilbert transformer needs an odd number of taps, at least 3")
+	}
+
+	coeffs := make([]float64, numTaps)
+	center := (numTaps - 1) / 2
+	window := windows.Get(windows.Hamming)(numTaps)
+
+	for n := range coeffs {
+		m := n - center
+		if m%2 != 0 {
+			coeffs[n] = 2 / (math.Pi * float64(m)) * window[n]
+		}
+	}
+	return coeffs
+}
+
+// HilbertDelay возвращает задержку синфазного канала в отсчетах, совпадающую
+// с групповой задержкой преобразователя DesignHilbertFIR(numTaps)
+func HilbertDelay(numTaps int) int {
+	return (numTaps - 1) / 2
+}
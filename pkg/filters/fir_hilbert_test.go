@@ -0,0 +1,77 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestDesignHilbertFIR_Symmetry проверяет антисимметрию и нули на четных смещениях
+func TestDesignHilbertFIR_Symmetry(t *testing.T) {
+	coeffs := DesignHilbertFIR(31)
+	center := HilbertDelay(31)
+	if center != 15 {
+		t.Fatalf("Задержка: ожидалось 15, получено %d", center)
+	}
+
+	for n, c := range coeffs {
+		if (n-center)%2 == 0 && c != 0 {
+			t.Errorf("Коэффициент %d: ожидался 0, получено %g", n, c)
+		}
+		if math.Abs(c+coeffs[len(coeffs)-1-n]) > 1e-15 {
+			t.Errorf("Коэффициенты %d и %d не антисимметричны", n, len(coeffs)-1-n)
+		}
+	}
+}
+
+// TestDesignHilbertFIR_Quadrature проверяет сдвиг фазы на 90° в средней части полосы
+func TestDesignHilbertFIR_Quadrature(t *testing.T) {
+	const numTaps = 63
+	delay := HilbertDelay(numTaps)
+
+	for _, freq := range []float64{0.1, 0.2, 0.25, 0.3, 0.4} {
+		hilbert := NewFIRFilter(DesignHilbertFIR(numTaps))
+		inPhase := NewDelayLine(delay + 1)
+		omega := 2 * math.Pi * freq
+
+		var dot, powerI, powerQ float64
+		for n := 0; n < 2000; n++ {
+			x := math.Cos(omega * float64(n))
+			q := hilbert.Tick(x)
+			inPhase.Push(x)
+			i := inPhase.Get(delay)
+
+			// Переходный процесс длиной в фильтр пропускается
+			if n < numTaps {
+				continue
+			}
+
+			// Квадратурный канал отстает на 90°: q = sin(ω(n-D))
+			want := math.Sin(omega * float64(n-delay))
+			if math.Abs(q-want) > 0.02 {
+				t.Fatalf("f=%.2f, отсчет %d: ожидалось %f, получено %f", freq, n, want, q)
+			}
+			dot += i * q
+			powerI += i * i
+			powerQ += q * q
+		}
+
+		// Каналы ортогональны: косинус угла между ними близок к нулю
+		if c := dot / math.Sqrt(powerI*powerQ); math.Abs(c) > 0.01 {
+			t.Errorf("f=%.2f: каналы не ортогональны, корреляция %f", freq, c)
+		}
+	}
+}
+
+// TestDesignHilbertFIR_InvalidParams проверяет панику при неверной длине
+func TestDesignHilbertFIR_InvalidParams(t *testing.T) {
+	for _, numTaps := range []int{0, 1, 4} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("numTaps=%d: ожидалась паника", numTaps)
+				}
+			}()
+			DesignHilbertFIR(numTaps)
+		}()
+	}
+}
@@ -0,0 +1,43 @@
+package filters
+
+import "math"
+
+// NormalizeDCGain масштабирует коэффициенты так, чтобы их сумма (усиление
+// на нулевой частоте) была равна 1. Возвращает новый срез; форма импульсной
+// характеристики сохраняется. Паникует, если сумма коэффициентов равна нулю
+// (например, для ФВЧ или полосового фильтра)
+func NormalizeDCGain(coeffs []float64) []float64 {
+	var sum float64
+	for _, c := range coeffs {
+		sum += c
+	}
+	if sum == 0 {
+		panic("FIRFilter: cannot normalize DC gain, coefficients sum to zero")
+	}
+
+	return scaleCoeffs(coeffs, 1/sum)
+}
+
+// NormalizeEnergy масштабирует коэффициенты так, чтобы сумма их квадратов
+// (энергия импульсной характеристики) была равна 1. Возвращает новый срез.
+// Паникует для пустого или нулевого набора коэффициентов
+func NormalizeEnergy(coeffs []float64) []float64 {
+	var energy float64
+	for _, c := range coeffs {
+		energy += c * c
+	}
+	if energy == 0 {
+		panic("FIRFilter: cannot normalize energy of all-zero coefficients")
+	}
+
+	return scaleCoeffs(coeffs, 1/math.Sqrt(energy))
+}
+
+// scaleCoeffs возвращает новый срез коэффициентов, умноженных на scale
+func scaleCoeffs(coeffs []float64, scale float64) []float64 {
+	scaled := make([]float64, len(coeffs))
+	for i, c := range coeffs {
+		scaled[i] = c * scale
+	}
+	return scaled
+}
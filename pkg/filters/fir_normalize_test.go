@@ -0,0 +1,73 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestNormalizeDCGain проверяет единичную сумму и сохранение формы
+func TestNormalizeDCGain(t *testing.T) {
+	coeffs := []float64{1, 2, 4, 2, 1}
+	normalized := NormalizeDCGain(coeffs)
+
+	var sum float64
+	for _, c := range normalized {
+		sum += c
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("Сумма: ожидалось 1, получено %f", sum)
+	}
+	for i := range coeffs {
+		if ratio := normalized[i] / coeffs[i]; math.Abs(ratio-0.1) > 1e-12 {
+			t.Errorf("Коэффициент %d: отношение %f, ожидалось 0.1", i, ratio)
+		}
+	}
+	if coeffs[2] != 4 {
+		t.Error("NormalizeDCGain изменил входной срез")
+	}
+}
+
+// TestNormalizeEnergy проверяет единичную энергию и сохранение формы
+func TestNormalizeEnergy(t *testing.T) {
+	coeffs := []float64{3, -4, 0}
+	normalized := NormalizeEnergy(coeffs)
+
+	var energy float64
+	for _, c := range normalized {
+		energy += c * c
+	}
+	if math.Abs(energy-1) > 1e-12 {
+		t.Errorf("Энергия: ожидалось 1, получено %f", energy)
+	}
+
+	expected := []float64{0.6, -0.8, 0}
+	for i := range expected {
+		if math.Abs(normalized[i]-expected[i]) > 1e-12 {
+			t.Errorf("Коэффициент %d: ожидалось %f, получено %f", i, expected[i], normalized[i])
+		}
+	}
+}
+
+// TestNormalize_ZeroInput проверяет панику для нулевых коэффициентов
+func TestNormalize_ZeroInput(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"DC: нулевые коэффициенты", func() { NormalizeDCGain([]float64{0, 0}) }},
+		{"DC: нулевая сумма", func() { NormalizeDCGain([]float64{1, -1}) }},
+		{"Энергия: нулевые коэффициенты", func() { NormalizeEnergy([]float64{0, 0, 0}) }},
+		{"Энергия: пустой срез", func() { NormalizeEnergy(nil) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -2,6 +2,7 @@ package filters
 
 import (
 	"math"
+	"math/cmplx"
 	"testing"
 )
 
@@ -264,6 +265,153 @@ func TestConsecutiveProcessing(t *testing.T) {
 	}
 }
 
+// TestFIRFilterProcessMatchesTick проверяет совпадение Process с циклом Tick
+func TestFIRFilterProcessMatchesTick(t *testing.T) {
+	coeffs := []float64{0.1, -0.3, 0.7, 0.25, -0.05}
+	tickFilter := NewFIRFilter(coeffs)
+	blockFilter := NewFIRFilter(coeffs)
+
+	input := make([]float64, 37)
+	for i := range input {
+		input[i] = math.Sin(0.3*float64(i)) + 0.1*float64(i%3)
+	}
+
+	expected := make([]float64, len(input))
+	for i, val := range input {
+		expected[i] = tickFilter.Tick(val)
+	}
+
+	// Обрабатываем блоками разной длины, чтобы проверить сшивку
+	var output []float64
+	for _, chunk := range [][]float64{input[:3], input[3:4], input[4:20], input[20:]} {
+		output = append(output, blockFilter.Process(chunk)...)
+	}
+
+	for i := range expected {
+		if output[i] != expected[i] {
+			t.Errorf("Отсчет %d: ожидалось %v, получено %v", i, expected[i], output[i])
+		}
+	}
+}
+
+// TestFIRFilterProcessEmpty проверяет обработку пустого среза
+func TestFIRFilterProcessEmpty(t *testing.T) {
+	filter := NewFIRFilter([]float64{1, 2, 3})
+
+	output := filter.Process([]float64{})
+	if output == nil {
+		t.Fatal("Ожидался пустой, но не nil срез")
+	}
+	if len(output) != 0 {
+		t.Errorf("Ожидалась длина 0, получено %d", len(output))
+	}
+
+	// Состояние не должно измениться
+	if out := filter.Tick(1.0); math.Abs(out-1.0) > 1e-10 {
+		t.Errorf("После пустого Process: ожидалось 1, получено %f", out)
+	}
+}
+
+// TestFIRFilterFrequencyResponseDFT сравнивает частотную характеристику
+// с прямым ДПФ импульсной характеристики
+func TestFIRFilterFrequencyResponseDFT(t *testing.T) {
+	coeffs := []float64{0.05, -0.12, 0.3, 0.55, 0.3, -0.12, 0.05, 0.02}
+	filter := NewFIRFilter(coeffs)
+
+	// Снимаем импульсную характеристику фильтра
+	impulse := make([]float64, len(coeffs))
+	impulse[0] = 1.0
+	h := NewFIRFilter(coeffs).Process(impulse)
+
+	for _, freq := range []float64{0, 0.05, 0.1, 0.2, 0.25, 0.33, 0.45, 0.5} {
+		var expected complex128
+		for n, val := range h {
+			angle := -2.0 * math.Pi * freq * float64(n)
+			expected += complex(val, 0) * complex(math.Cos(angle), math.Sin(angle))
+		}
+
+		got := filter.GetFrequencyResponse(freq)
+		if cmplx.Abs(got-expected) > 1e-12 {
+			t.Errorf("Частота %.2f: ожидалось %v, получено %v", freq, expected, got)
+		}
+	}
+}
+
+// TestFIRFilterLinearPhase проверяет линейность ФЧХ симметричного фильтра
+func TestFIRFilterLinearPhase(t *testing.T) {
+	coeffs := []float64{0.1, 0.2, 0.4, 0.2, 0.1}
+	filter := NewFIRFilter(coeffs)
+	center := float64(len(coeffs)-1) / 2
+
+	for freq := 0.0; freq <= 0.5; freq += 0.01 {
+		h := filter.GetFrequencyResponse(freq)
+
+		// Компенсируем линейную фазу: остаток должен быть вещественным
+		omega := 2.0 * math.Pi * freq
+		amplitude := h * complex(math.Cos(omega*center), math.Sin(omega*center))
+		if math.Abs(imag(amplitude)) > 1e-12 {
+			t.Errorf("Частота %.2f: фаза не линейна, мнимая часть %e", freq, imag(amplitude))
+		}
+	}
+
+	// На нулевой частоте коэффициент передачи равен сумме коэффициентов
+	if dc := filter.GetFrequencyResponse(0); math.Abs(real(dc)-1.0) > 1e-12 {
+		t.Errorf("Коэффициент передачи на DC: ожидалось 1, получено %v", dc)
+	}
+}
+
+// TestFIRFilterFrequencyResponsePanic проверяет панику вне диапазона частот
+func TestFIRFilterFrequencyResponsePanic(t *testing.T) {
+	filter := NewFIRFilter([]float64{1, 1})
+
+	for _, freq := range []float64{-0.1, 0.6} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Ожидалась паника для частоты %v", freq)
+				}
+			}()
+			filter.GetFrequencyResponse(freq)
+		}()
+	}
+}
+
+// TestFIRFilterGroupDelay проверяет групповую задержку фильтра
+func TestFIRFilterGroupDelay(t *testing.T) {
+	t.Run("симметричный фильтр", func(t *testing.T) {
+		coeffs := []float64{0.05, 0.1, 0.2, 0.3, 0.2, 0.1, 0.05}
+		filter := NewFIRFilter(coeffs)
+		expected := float64(len(coeffs)-1) / 2
+
+		for freq := 0.0; freq <= 0.5; freq += 0.02 {
+			gd := filter.GetGroupDelay(freq)
+			if math.Abs(gd-expected) > 1e-9 {
+				t.Errorf("Частота %.2f: ожидалось %f, получено %f", freq, expected, gd)
+			}
+		}
+	})
+
+	t.Run("чистая задержка", func(t *testing.T) {
+		filter := NewFIRFilter([]float64{0, 0, 0, 1})
+
+		for _, freq := range []float64{0, 0.1, 0.3, 0.5} {
+			if gd := filter.GetGroupDelay(freq); math.Abs(gd-3) > 1e-9 {
+				t.Errorf("Частота %.2f: ожидалось 3, получено %f", freq, gd)
+			}
+		}
+	})
+
+	t.Run("ноль передаточной функции", func(t *testing.T) {
+		// [1, 1] имеет нуль на частоте Найквиста
+		filter := NewFIRFilter([]float64{1, 1})
+
+		gd := filter.GetGroupDelay(0.5)
+		if math.IsNaN(gd) || gd != 0 {
+			t.Errorf("Ожидалось 0 в нуле передаточной функции, получено %f", gd)
+		}
+	})
+}
+
 // BenchmarkFIRFilterTick тестирует производительность
 func BenchmarkFIRFilterTick(b *testing.B) {
 	// Фильтр с 64 коэффициентами
@@ -279,3 +427,22 @@ func BenchmarkFIRFilterTick(b *testing.B) {
 		filter.Tick(float64(i))
 	}
 }
+
+// BenchmarkFIRFilterProcess тестирует производительность блочной обработки
+func BenchmarkFIRFilterProcess(b *testing.B) {
+	coeffs := make([]float64, 64)
+	for i := range coeffs {
+		coeffs[i] = 1.0 / 64.0
+	}
+
+	filter := NewFIRFilter(coeffs)
+	input := make([]float64, 4096)
+	for i := range input {
+		input[i] = float64(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filter.Process(input)
+	}
+}
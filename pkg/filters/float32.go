@@ -0,0 +1,179 @@
+package filters
+
+// FIRFilter32 - КИХ-фильтр одинарной точности для аудиотрактов на float32.
+// Повторяет алгоритм FIRFilter без преобразования отсчетов во float64
+type FIRFilter32 struct {
+	coeffs []float32 // Коэффициенты фильтра
+	buffer []float32 // Кольцевой буфер задержанных отсчетов сигнала
+	pos    int       // Текущая позиция в буфере
+}
+
+// NewFIRFilter32 создает КИХ-фильтр одинарной точности
+func NewFIRFilter32(coeffs []float32) *FIRFilter32 {
+	if len(coeffs) == 0 {
+		panic("FIRFilter32: coefficients cannot be empty")
+	}
+
+	n := len(coeffs)
+	return &FIRFilter32{
+		coeffs: append([]float32{}, coeffs...),
+		buffer: make([]float32, n),
+		pos:    n - 1,
+	}
+}
+
+// Tick применяет фильтр к одному новому отсчету
+func (f *FIRFilter32) Tick(input float32) float32 {
+	f.pos++
+	if f.pos == len(f.buffer) {
+		f.pos = 0
+	}
+	f.buffer[f.pos] = input
+
+	var output float32
+	bufIdx := f.pos
+	for _, c := range f.coeffs {
+		output += c * f.buffer[bufIdx]
+		bufIdx--
+		if bufIdx < 0 {
+			bufIdx = len(f.buffer) - 1
+		}
+	}
+
+	return output
+}
+
+// Process обрабатывает весь срез входных данных, сохраняя состояние между вызовами
+func (f *FIRFilter32) Process(input []float32) []float32 {
+	output := make([]float32, len(input))
+	for i, val := range input {
+		output[i] = f.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние фильтра (очищает буфер)
+func (f *FIRFilter32) Reset() {
+	for i := range f.buffer {
+		f.buffer[i] = 0
+	}
+	f.pos = len(f.buffer) - 1
+}
+
+// GetCoefficients возвращает копию коэффициентов фильтра
+func (f *FIRFilter32) GetCoefficients() []float32 {
+	return append([]float32{}, f.coeffs...)
+}
+
+// IIRFilter32 - БИХ-фильтр одинарной точности (прямая форма I), аналог IIRFilter
+type IIRFilter32 struct {
+	bCoeffs []float32 // Коэффициенты числителя (feedforward)
+	aCoeffs []float32 // Коэффициенты знаменателя (feedback), a[0] = 1
+
+	xBuffer []float32 // Буфер входных отсчетов
+	yBuffer []float32 // Буфер выходных отсчетов
+
+	xPos int // Текущая позиция во входном буфере
+	yPos int // Текущая позиция в выходном буфере
+}
+
+// NewIIRFilter32 создает БИХ-фильтр одинарной точности.
+// Коэффициенты нормируются так, чтобы a[0] = 1
+func NewIIRFilter32(bCoeffs, aCoeffs []float32) *IIRFilter32 {
+	if len(bCoeffs) == 0 {
+		panic("IIRFilter32: b coefficients cannot be empty")
+	}
+	if len(aCoeffs) == 0 {
+		panic("IIRFilter32: a coefficients cannot be empty")
+	}
+	if aCoeffs[0] == 0 {
+		panic("IIRFilter32: a[0] cannot be zero")
+	}
+
+	b := append([]float32{}, bCoeffs...)
+	a := append([]float32{}, aCoeffs...)
+	if normalizer := a[0]; normalizer != 1 {
+		for i := range b {
+			b[i] /= normalizer
+		}
+		for i := range a {
+			a[i] /= normalizer
+		}
+	}
+
+	return &IIRFilter32{
+		bCoeffs: b,
+		aCoeffs: a,
+		xBuffer: make([]float32, len(b)),
+		yBuffer: make([]float32, len(a)),
+	}
+}
+
+// NewIIRFilter32From создает фильтр одинарной точности с коэффициентами
+// готового фильтра IIRFilter (например, рассчитанного функциями проектирования)
+func NewIIRFilter32From(filter *IIRFilter) *IIRFilter32 {
+	return NewIIRFilter32(toFloat32(filter.bCoeffs), toFloat32(filter.aCoeffs))
+}
+
+// Tick применяет фильтр к одному новому отсчету
+func (f *IIRFilter32) Tick(input float32) float32 {
+	f.xBuffer[f.xPos] = input
+
+	// Прямая часть: b0*x[n] + b1*x[n-1] + ...
+	var output float32
+	idx := f.xPos
+	for _, b := range f.bCoeffs {
+		output += b * f.xBuffer[idx]
+		idx--
+		if idx < 0 {
+			idx = len(f.xBuffer) - 1
+		}
+	}
+
+	// Обратная часть: -a1*y[n-1] - a2*y[n-2] - ...
+	idx = f.yPos
+	for _, a := range f.aCoeffs[1:] {
+		idx--
+		if idx < 0 {
+			idx = len(f.yBuffer) - 1
+		}
+		output -= a * f.yBuffer[idx]
+	}
+
+	f.yBuffer[f.yPos] = output
+
+	f.xPos = (f.xPos + 1) % len(f.xBuffer)
+	f.yPos = (f.yPos + 1) % len(f.yBuffer)
+
+	return output
+}
+
+// Process обрабатывает весь срез входных данных
+func (f *IIRFilter32) Process(input []float32) []float32 {
+	output := make([]float32, len(input))
+	for i, val := range input {
+		output[i] = f.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние фильтра (очищает буферы)
+func (f *IIRFilter32) Reset() {
+	for i := range f.xBuffer {
+		f.xBuffer[i] = 0
+	}
+	for i := range f.yBuffer {
+		f.yBuffer[i] = 0
+	}
+	f.xPos = 0
+	f.yPos = 0
+}
+
+// toFloat32 преобразует срез коэффициентов к одинарной точности
+func toFloat32(values []float64) []float32 {
+	out := make([]float32, len(values))
+	for i, v := range values {
+		out[i] = float32(v)
+	}
+	return out
+}
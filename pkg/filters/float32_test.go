@@ -0,0 +1,110 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// testSignal32 возвращает тестовый сигнал одновременно в двух точностях
+func testSignal32(n int) ([]float64, []float32) {
+	signal64 := make([]float64, n)
+	signal32 := make([]float32, n)
+	for i := range signal64 {
+		signal64[i] = math.Sin(0.05*float64(i)) + 0.3*math.Cos(1.3*float64(i))
+		signal32[i] = float32(signal64[i])
+	}
+	return signal64, signal32
+}
+
+// TestFIRFilter32_MatchesFloat64 сравнивает скользящее среднее в float32 и float64
+func TestFIRFilter32_MatchesFloat64(t *testing.T) {
+	signal64, signal32 := testSignal32(5000)
+
+	for _, n := range []int{1, 8, 64} {
+		coeffs := movingAverageCoeffs(n)
+		fir64 := NewFIRFilter(coeffs)
+		fir32 := NewFIRFilter32(toFloat32(coeffs))
+
+		want := fir64.Process(signal64)
+		got := fir32.Process(signal32)
+		for i := range want {
+			if math.Abs(float64(got[i])-want[i]) > 1e-5 {
+				t.Fatalf("N=%d, отсчет %d: ожидалось %f, получено %f", n, i, want[i], got[i])
+			}
+		}
+	}
+}
+
+// TestFIRFilter32_Reset проверяет сброс состояния и совпадение Tick с Process
+func TestFIRFilter32_Reset(t *testing.T) {
+	fir := NewFIRFilter32([]float32{0.5, 0.5})
+	first := fir.Process([]float32{2, 4, 6})
+
+	fir.Reset()
+	for i, val := range []float32{2, 4, 6} {
+		if got := fir.Tick(val); got != first[i] {
+			t.Errorf("Отсчет %d после Reset: ожидалось %f, получено %f", i, first[i], got)
+		}
+	}
+}
+
+// TestIIRFilter32_MatchesFloat64 сравнивает БИХ-фильтр 2-го порядка в двух точностях
+func TestIIRFilter32_MatchesFloat64(t *testing.T) {
+	signal64, signal32 := testSignal32(5000)
+
+	iir64 := NewSecondOrderLowPass(0.05, 0.707)
+	iir32 := NewIIRFilter32From(iir64)
+
+	want := iir64.Process(signal64)
+	got := iir32.Process(signal32)
+	for i := range want {
+		if math.Abs(float64(got[i])-want[i]) > 1e-4 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, want[i], got[i])
+		}
+	}
+
+	iir32.Reset()
+	again := iir32.Process(signal32)
+	for i := range got {
+		if again[i] != got[i] {
+			t.Fatalf("Отсчет %d после Reset: ожидалось %f, получено %f", i, got[i], again[i])
+		}
+	}
+}
+
+// TestIIRFilter32_Normalization проверяет нормировку по a[0]
+func TestIIRFilter32_Normalization(t *testing.T) {
+	f := NewIIRFilter32([]float32{2}, []float32{2, -1})
+	// y[n] = x[n] + 0.5*y[n-1]
+	expected := []float32{1, 0.5, 0.25}
+	got := f.Process([]float32{1, 0, 0})
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], got[i])
+		}
+	}
+}
+
+// BenchmarkFIRFilter_Process64 измеряет обработку большого буфера во float64
+func BenchmarkFIRFilter_Process64(b *testing.B) {
+	signal, _ := testSignal32(1 << 16)
+	fir := NewFIRFilter(movingAverageCoeffs(64))
+	b.SetBytes(int64(len(signal) * 8))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fir.Process(signal)
+	}
+}
+
+// BenchmarkFIRFilter32_Process измеряет обработку того же буфера во float32
+func BenchmarkFIRFilter32_Process(b *testing.B) {
+	_, signal := testSignal32(1 << 16)
+	fir := NewFIRFilter32(toFloat32(movingAverageCoeffs(64)))
+	b.SetBytes(int64(len(signal) * 4))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fir.Process(signal)
+	}
+}
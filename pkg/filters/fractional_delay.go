@@ -0,0 +1,100 @@
+package filters
+
+import "math"
+
+// FractionalDelay задерживает сигнал на нецелое число отсчетов.
+// Дробная часть реализуется КИХ-фильтром интерполяции Лагранжа порядка N:
+//
+//	h[k] = prod((d - i) / (k - i)), i = 0..N, i != k
+//
+// Интерполятор точнее всего, когда d лежит в середине его окна
+// ((N-1)/2 <= d <= (N+1)/2), поэтому остальная часть задержки выполняется
+// целочисленным сдвигом по линии задержки
+type FractionalDelay struct {
+	delay  float64    // Полная задержка в отсчетах
+	order  int        // Порядок интерполяции N
+	shift  int        // Целочисленный сдвиг перед интерполятором
+	coeffs []float64  // Коэффициенты интерполятора Лагранжа (N+1)
+	line   *DelayLine // Линия задержки длины shift+N+1
+}
+
+// NewFractionalDelay создает линию дробной задержки на delay отсчетов
+// (delay >= 0) с интерполяцией Лагранжа порядка order (order >= 1).
+// При delay < (order-1)/2 интерполятор работает вне своего оптимального
+// диапазона и погрешность на высоких частотах растет
+func NewFractionalDelay(delay float64, order int) *FractionalDelay {
+	if delay < 0 || math.IsInf(delay, 0) || math.IsNaN(delay) {
+		panic("FractionalDelay: delay must be non-negative and finite")
+	}
+	if order < 1 {
+		panic("FractionalDelay: order must be at least 1")
+	}
+
+	shift := int(math.Floor(delay - float64(order-1)/2))
+	if shift < 0 {
+		shift = 0
+	}
+	d := delay - float64(shift)
+
+	coeffs := make([]float64, order+1)
+	for k := range coeffs {
+		h := 1.0
+		for i := 0; i <= order; i++ {
+			if i != k {
+				h *= (d - float64(i)) / float64(k-i)
+			}
+		}
+		coeffs[k] = h
+	}
+
+	return &FractionalDelay{
+		delay:  delay,
+		order:  order,
+		shift:  shift,
+		coeffs: coeffs,
+		line:   NewDelayLine(shift + order + 1),
+	}
+}
+
+// Tick задерживает один отсчет
+func (fd *FractionalDelay) Tick(input float64) float64 {
+	fd.line.Push(input)
+
+	var output float64
+	for k, c := range fd.coeffs {
+		output += c * fd.line.Get(fd.shift+k)
+	}
+	return output
+}
+
+// Process задерживает весь срез входных данных
+func (fd *FractionalDelay) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = fd.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние линии задержки
+func (fd *FractionalDelay) Reset() {
+	fd.line.Reset()
+}
+
+// GetDelay возвращает заданную задержку в отсчетах
+func (fd *FractionalDelay) GetDelay() float64 {
+	return fd.delay
+}
+
+// GetOrder возвращает порядок интерполяции
+func (fd *FractionalDelay) GetOrder() int {
+	return fd.order
+}
+
+// GetCoefficients возвращает полную импульсную характеристику
+// (целочисленный сдвиг и коэффициенты интерполятора)
+func (fd *FractionalDelay) GetCoefficients() []float64 {
+	coeffs := make([]float64, fd.shift+len(fd.coeffs))
+	copy(coeffs[fd.shift:], fd.coeffs)
+	return coeffs
+}
@@ -0,0 +1,58 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestFractionalDelay_HalfSample сравнивает задержанную на 0.5 отсчета
+// медленную синусоиду с аналитически сдвинутой
+func TestFractionalDelay_HalfSample(t *testing.T) {
+	const omega = 2 * math.Pi * 0.01
+
+	for _, order := range []int{1, 3, 5} {
+		fd := NewFractionalDelay(0.5, order)
+
+		for n := 0; n < 500; n++ {
+			got := fd.Tick(math.Sin(omega * float64(n)))
+			if n < 2*order {
+				continue // Переходный процесс
+			}
+			want := math.Sin(omega * (float64(n) - 0.5))
+			if math.Abs(got-want) > 1e-3 {
+				t.Fatalf("Порядок %d, отсчет %d: ожидалось %f, получено %f", order, n, want, got)
+			}
+		}
+	}
+}
+
+// TestFractionalDelay_GroupDelay проверяет групповую задержку на низких частотах
+func TestFractionalDelay_GroupDelay(t *testing.T) {
+	for _, delay := range []float64{0.5, 3.4, 7.75} {
+		fd := NewFractionalDelay(delay, 3)
+		fir := NewFIRFilter(fd.GetCoefficients())
+
+		if gd := fir.GetGroupDelay(0.01); math.Abs(gd-delay) > 1e-3 {
+			t.Errorf("Задержка %.2f: групповая задержка %f", delay, gd)
+		}
+	}
+}
+
+// TestFractionalDelay_IntegerDelay проверяет, что целая задержка дает чистый сдвиг
+func TestFractionalDelay_IntegerDelay(t *testing.T) {
+	fd := NewFractionalDelay(4, 3)
+
+	input := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	expected := []float64{0, 0, 0, 0, 1, 2, 3, 4}
+	output := fd.Process(input)
+	for i := range expected {
+		if math.Abs(output[i]-expected[i]) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], output[i])
+		}
+	}
+
+	fd.Reset()
+	if got := fd.Tick(1); got != 0 {
+		t.Errorf("После Reset: ожидалось 0, получено %f", got)
+	}
+}
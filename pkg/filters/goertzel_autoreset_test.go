@@ -0,0 +1,87 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestGoertzelFilter_AutoResetLatches проверяет непрерывный анализ трех
+// блоков стационарного тона без ручного Reset
+func TestGoertzelFilter_AutoResetLatches(t *testing.T) {
+	const (
+		fs        = 8000.0
+		totalN    = 200
+		freq      = 1000.0
+		amplitude = 0.5
+	)
+
+	gf, err := NewGoertzelFilterAutoReset(freq, fs, totalN)
+	if err != nil {
+		t.Fatalf("Ошибка создания фильтра: %v", err)
+	}
+
+	if _, err := gf.LatchedMagnitude(); err == nil {
+		t.Error("До завершения блока ожидалась ошибка")
+	}
+
+	var latched []float64
+	for i := 0; i < 3*totalN; i++ {
+		sample := amplitude * math.Sin(2*math.Pi*freq*float64(i)/fs)
+		if err := gf.Process(sample); err != nil {
+			t.Fatalf("Отсчет %d: ошибка %v", i, err)
+		}
+		if (i+1)%totalN == 0 {
+			mag, err := gf.LatchedMagnitude()
+			if err != nil {
+				t.Fatalf("Блок %d: ошибка %v", len(latched), err)
+			}
+			latched = append(latched, mag)
+		}
+	}
+
+	if gf.GetCompletedBlocks() != 3 {
+		t.Errorf("Завершенных блоков: ожидалось 3, получено %d", gf.GetCompletedBlocks())
+	}
+	for i, mag := range latched {
+		if math.Abs(mag-amplitude) > 1e-9 {
+			t.Errorf("Блок %d: ожидалась амплитуда %f, получено %f", i, amplitude, mag)
+		}
+	}
+}
+
+// TestGoertzelFilter_AutoResetBlocks проверяет обработку блоками,
+// пересекающими границы блоков анализа
+func TestGoertzelFilter_AutoResetBlocks(t *testing.T) {
+	gf, _ := NewGoertzelFilterAutoReset(1000, 8000, 80)
+
+	// Первые 80 отсчетов - тон (ровно 10 периодов), следующие 80 - тишина
+	samples := make([]float64, 200)
+	for i := 0; i < 80; i++ {
+		samples[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / 8000)
+	}
+
+	if err := gf.ProcessBlock(samples[:120]); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+	if mag, _ := gf.LatchedMagnitude(); math.Abs(mag-1) > 1e-9 {
+		t.Errorf("Первый блок: ожидалось 1, получено %f", mag)
+	}
+
+	if err := gf.ProcessBlock(samples[120:]); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+	if mag, _ := gf.LatchedMagnitude(); mag > 1e-12 {
+		t.Errorf("Второй блок: ожидалось 0, получено %f", mag)
+	}
+	if gf.GetProcessedCount() != 40 {
+		t.Errorf("Отсчетов в текущем блоке: ожидалось 40, получено %d", gf.GetProcessedCount())
+	}
+}
+
+// TestGoertzelFilter_LatchedWithoutAutoReset проверяет ошибку в обычном режиме
+func TestGoertzelFilter_LatchedWithoutAutoReset(t *testing.T) {
+	gf, _ := NewGoertzelFilter(1000, 8000, 100)
+	if _, err := gf.LatchedMagnitude(); err == nil {
+		t.Error("Без режима автоперезапуска ожидалась ошибка")
+	}
+}
@@ -0,0 +1,152 @@
+package filters
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNoDTMFTone возвращается, если в блоке не найдена пара тонов DTMF
+var ErrNoDTMFTone = errors.New("no DTMF tone detected")
+
+// Частоты DTMF: строки (нижняя группа) и столбцы (верхняя группа)
+var (
+	dtmfRowFreqs = []float64{697, 770, 852, 941}
+	dtmfColFreqs = []float64{1209, 1336, 1477, 1633}
+	dtmfKeys     = [4][4]rune{
+		{'1', '2', '3', 'A'},
+		{'4', '5', '6', 'B'},
+		{'7', '8', '9', 'C'},
+		{'*', '0', '#', 'D'},
+	}
+)
+
+// dtmfMinLevel - минимальная амплитуда тона относительно СКЗ сигнала
+const dtmfMinLevel = 0.5
+
+// GoertzelBank представляет собой набор фильтров Герцеля для нескольких частот.
+// Фильтры работают в точном режиме (NewGoertzelFilterExact), чтобы близкие
+// частоты не округлялись до одного бина
+type GoertzelBank struct {
+	filters []*GoertzelFilter // Фильтры для каждой целевой частоты
+	freqs   []float64         // Целевые частоты
+}
+
+// NewGoertzelBank создает набор фильтров Герцеля для заданных частот
+func NewGoertzelBank(freqs []float64, samplingRate float64, totalN int) (*GoertzelBank, error) {
+	if len(freqs) == 0 {
+		return nil, &InvalidParameterError{Param: "freqs", Value: 0, Reason: "at least one frequency is required"}
+	}
+
+	filters := make([]*GoertzelFilter, len(freqs))
+	for i, freq := range freqs {
+		filter, err := NewGoertzelFilterExact(freq, samplingRate, totalN)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+
+	return &GoertzelBank{
+		filters: filters,
+		freqs:   append([]float64{}, freqs...),
+	}, nil
+}
+
+// ProcessBlock обрабатывает блок отсчетов всеми фильтрами набора
+func (gb *GoertzelBank) ProcessBlock(samples []float64) error {
+	if gb == nil {
+		return &InvalidStateError{Reason: "filter bank is not initialized"}
+	}
+
+	for _, filter := range gb.filters {
+		if err := filter.ProcessBlock(samples); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Magnitudes возвращает амплитуды всех частот набора (0 для необработанных фильтров)
+func (gb *GoertzelBank) Magnitudes() []float64 {
+	if gb == nil {
+		return nil
+	}
+
+	magnitudes := make([]float64, len(gb.filters))
+	for i, filter := range gb.filters {
+		magnitude, err := filter.GetMagnitude()
+		if err != nil {
+			continue
+		}
+		magnitudes[i] = magnitude
+	}
+	return magnitudes
+}
+
+// Reset сбрасывает состояние всех фильтров набора
+func (gb *GoertzelBank) Reset() error {
+	if gb == nil {
+		return &InvalidStateError{Reason: "filter bank is not initialized"}
+	}
+
+	for _, filter := range gb.filters {
+		if err := filter.Reset(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFrequencies возвращает копию целевых частот набора
+func (gb *GoertzelBank) GetFrequencies() []float64 {
+	if gb == nil {
+		return nil
+	}
+	return append([]float64{}, gb.freqs...)
+}
+
+// DecodeDTMF определяет клавишу DTMF по блоку отсчетов, выбирая
+// самые сильные тоны в группах строк и столбцов
+func DecodeDTMF(samples []float64, samplingRate float64) (rune, error) {
+	if len(samples) == 0 {
+		return 0, &InvalidParameterError{Param: "samples", Value: 0, Reason: "samples cannot be empty"}
+	}
+
+	freqs := append(append([]float64{}, dtmfRowFreqs...), dtmfColFreqs...)
+	bank, err := NewGoertzelBank(freqs, samplingRate, len(samples))
+	if err != nil {
+		return 0, err
+	}
+	if err := bank.ProcessBlock(samples); err != nil {
+		return 0, err
+	}
+
+	magnitudes := bank.Magnitudes()
+	row := argMax(magnitudes[:len(dtmfRowFreqs)])
+	col := argMax(magnitudes[len(dtmfRowFreqs):])
+
+	// Оба тона должны быть заметны на фоне общего уровня сигнала
+	var energy float64
+	for _, s := range samples {
+		energy += s * s
+	}
+	rms := math.Sqrt(energy / float64(len(samples)))
+	threshold := dtmfMinLevel * rms
+
+	if rms == 0 || magnitudes[row] < threshold || magnitudes[len(dtmfRowFreqs)+col] < threshold {
+		return 0, ErrNoDTMFTone
+	}
+
+	return dtmfKeys[row][col], nil
+}
+
+// argMax возвращает индекс максимального элемента
+func argMax(values []float64) int {
+	best := 0
+	for i, v := range values {
+		if v > values[best] {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,122 @@
+package filters
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// dtmfSignal синтезирует пару тонов DTMF
+func dtmfSignal(rowFreq, colFreq, samplingRate float64, n int) []float64 {
+	signal := make([]float64, n)
+	for i := range signal {
+		t := float64(i) / samplingRate
+		signal[i] = 0.5*math.Sin(2*math.Pi*rowFreq*t) + 0.5*math.Sin(2*math.Pi*colFreq*t)
+	}
+	return signal
+}
+
+// TestGoertzelBank_Magnitudes проверяет амплитуды набора фильтров
+func TestGoertzelBank_Magnitudes(t *testing.T) {
+	samplingRate := 8000.0
+	totalN := 400
+	freqs := []float64{697, 1209, 1477}
+
+	bank, err := NewGoertzelBank(freqs, samplingRate, totalN)
+	if err != nil {
+		t.Fatalf("failed to create bank: %v", err)
+	}
+
+	signal := dtmfSignal(697, 1209, samplingRate, totalN)
+	if err := bank.ProcessBlock(signal); err != nil {
+		t.Fatalf("failed to process block: %v", err)
+	}
+
+	magnitudes := bank.Magnitudes()
+	if len(magnitudes) != len(freqs) {
+		t.Fatalf("expected %d magnitudes, got %d", len(freqs), len(magnitudes))
+	}
+	if math.Abs(magnitudes[0]-0.5) > 0.02 || math.Abs(magnitudes[1]-0.5) > 0.02 {
+		t.Errorf("tone magnitudes = %v, want ~0.5", magnitudes[:2])
+	}
+	if magnitudes[2] > 0.05 {
+		t.Errorf("absent tone magnitude = %v, want < 0.05", magnitudes[2])
+	}
+
+	// Блок не должен превышать totalN
+	if err := bank.ProcessBlock([]float64{0}); err == nil {
+		t.Error("expected error when block exceeds totalN")
+	}
+
+	if err := bank.Reset(); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	for i, m := range bank.Magnitudes() {
+		if m != 0 {
+			t.Errorf("magnitude %d after reset = %v, want 0", i, m)
+		}
+	}
+}
+
+// TestGoertzelBank_InvalidParams проверяет обработку неверных параметров
+func TestGoertzelBank_InvalidParams(t *testing.T) {
+	if _, err := NewGoertzelBank(nil, 8000, 100); err == nil {
+		t.Error("expected error for empty frequency list")
+	}
+	if _, err := NewGoertzelBank([]float64{697, 5000}, 8000, 100); err == nil {
+		t.Error("expected error for frequency above Nyquist")
+	}
+}
+
+// TestDecodeDTMF проверяет декодирование всех клавиш DTMF
+func TestDecodeDTMF(t *testing.T) {
+	samplingRate := 8000.0
+	n := 205
+
+	// Основной случай: 697/1209 Гц -> '1'
+	key, err := DecodeDTMF(dtmfSignal(697, 1209, samplingRate, n), samplingRate)
+	if err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if key != '1' {
+		t.Errorf("decoded %q, want '1'", key)
+	}
+
+	for r, rowFreq := range dtmfRowFreqs {
+		for c, colFreq := range dtmfColFreqs {
+			want := dtmfKeys[r][c]
+			got, err := DecodeDTMF(dtmfSignal(rowFreq, colFreq, samplingRate, n), samplingRate)
+			if err != nil {
+				t.Errorf("%q: failed to decode: %v", want, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("decoded %q, want %q", got, want)
+			}
+		}
+	}
+}
+
+// TestDecodeDTMF_NoTone проверяет отсутствие ложных срабатываний
+func TestDecodeDTMF_NoTone(t *testing.T) {
+	samplingRate := 8000.0
+	n := 205
+
+	if _, err := DecodeDTMF(make([]float64, n), samplingRate); !errors.Is(err, ErrNoDTMFTone) {
+		t.Errorf("silence: expected ErrNoDTMFTone, got %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	noise := make([]float64, n)
+	for i := range noise {
+		noise[i] = rng.NormFloat64()
+	}
+	if _, err := DecodeDTMF(noise, samplingRate); !errors.Is(err, ErrNoDTMFTone) {
+		t.Errorf("noise: expected ErrNoDTMFTone, got %v", err)
+	}
+
+	if _, err := DecodeDTMF(nil, samplingRate); err == nil {
+		t.Error("expected error for empty input")
+	}
+}
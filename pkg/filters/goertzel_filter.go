@@ -3,6 +3,9 @@ package filters
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
+
+	"dsp_go/pkg/windows"
 )
 
 // GoertzelFilter представляет собой структуру фильтра Герцеля для выявления одной частоты
@@ -16,6 +19,14 @@ type GoertzelFilter struct {
 	n      int     // Текущий отсчёт
 	totalN int     // Полное количество выборок для анализа
 	coeff  float64 // Коэффициент для рекуррентной формулы: 2*cos(w)
+	exact  bool    // Точная (нецелая) частота анализа без привязки к бину k
+
+	windowGain float64   // Когерентное усиление окна, примененного к входу (1 - без окна)
+	window     []float64 // Окно, умножаемое на входные отсчеты (nil - без окна)
+
+	autoReset bool    // Автоматический перезапуск после каждых totalN отсчетов
+	latched   float64 // Амплитуда последнего завершенного блока (режим autoReset)
+	blocks    int     // Число завершенных блоков (режим autoReset)
 }
 
 // NewGoertzelFilter создает новый экземпляр фильтра Герцеля
@@ -58,22 +69,96 @@ func NewGoertzelFilter(freq float64, samplingRate float64, totalN int) (*Goertze
 		q2:     0,
 		n:      0,
 		totalN: totalN,
+
+		windowGain: 1,
 	}, nil
 }
 
+// NewGoertzelFilterExact создает фильтр Герцеля для произвольной (не кратной бину) частоты.
+// В отличие от NewGoertzelFilter, частота не округляется до ближайшего бина k:
+// w = 2*pi*freq/samplingRate (обобщенный алгоритм Герцеля)
+func NewGoertzelFilterExact(freq float64, samplingRate float64, totalN int) (*GoertzelFilter, error) {
+	gf, err := NewGoertzelFilter(freq, samplingRate, totalN)
+	if err != nil {
+		return nil, err
+	}
+
+	w := 2 * math.Pi * freq / samplingRate
+	gf.w = w
+	gf.cosW = math.Cos(w)
+	gf.sinW = math.Sin(w)
+	gf.coeff = 2 * gf.cosW
+	gf.exact = true
+
+	return gf, nil
+}
+
+// NewGoertzelFilterAutoReset создает фильтр Герцеля для непрерывного анализа
+// следующих друг за другом блоков. По завершении каждого блока из totalN отсчетов
+// амплитуда фиксируется (см. LatchedMagnitude), состояние сбрасывается и
+// начинается следующий блок, поэтому вызывать Reset между блоками не нужно
+func NewGoertzelFilterAutoReset(freq float64, samplingRate float64, totalN int) (*GoertzelFilter, error) {
+	gf, err := NewGoertzelFilter(freq, samplingRate, totalN)
+	if err != nil {
+		return nil, err
+	}
+
+	gf.autoReset = true
+	return gf, nil
+}
+
+// NewGoertzelFilterWindowed создает фильтр Герцеля, который сам взвешивает
+// входные отсчеты окном: n-й отсчет блока умножается на window[n] перед
+// рекуррентным соотношением. Длина блока totalN равна len(window), а
+// когерентное усиление окна (windows.CoherentGain) задается автоматически,
+// поэтому GetMagnitude возвращает истинную амплитуду тона. В отличие от
+// SetWindowGain, усиление не ограничено единицей: окно может быть
+// масштабировано произвольно, если его среднее конечно и положительно
+func NewGoertzelFilterWindowed(freq float64, samplingRate float64, window []float64) (*GoertzelFilter, error) {
+	if len(window) == 0 {
+		return nil, &InvalidParameterError{Param: "window", Value: 0, Reason: "window cannot be empty"}
+	}
+
+	gf, err := NewGoertzelFilter(freq, samplingRate, len(window))
+	if err != nil {
+		return nil, err
+	}
+	gain := windows.CoherentGain(window)
+	if gain <= 0 || math.IsNaN(gain) || math.IsInf(gain, 0) {
+		return nil, &InvalidParameterError{Param: "window", Value: gain, Reason: "window coherent gain must be finite and positive"}
+	}
+
+	gf.windowGain = gain
+	gf.window = append([]float64{}, window...)
+	return gf, nil
+}
+
+// weight взвешивает отсчет с номером pos в блоке заданным окном
+func (gf *GoertzelFilter) weight(input float64, pos int) float64 {
+	if gf.window == nil {
+		return input
+	}
+	return input * gf.window[pos]
+}
+
 // Process обрабатывает одно значение сигнала и накапливает состояние фильтра
 func (gf *GoertzelFilter) Process(input float64) error {
 	if gf == nil {
 		return &InvalidStateError{Reason: "filter is not initialized"}
 	}
 
+	if gf.autoReset {
+		gf.processContinuous(input)
+		return nil
+	}
+
 	if gf.n >= gf.totalN {
 		return &InvalidStateError{Reason: "all samples have already been processed"}
 	}
 
 	// Основное рекуррентное соотношение фильтра Герцеля:
 	// q[n] = x[n] + coeff * q[n-1] - q[n-2]
-	q0 := input + gf.coeff*gf.q1 - gf.q2
+	q0 := gf.weight(input, gf.n) + gf.coeff*gf.q1 - gf.q2
 
 	// Сдвигаем состояния
 	gf.q2 = gf.q1
@@ -83,7 +168,57 @@ func (gf *GoertzelFilter) Process(input float64) error {
 	return nil
 }
 
+// processContinuous обрабатывает отсчет в режиме автоперезапуска:
+// по завершении блока фиксирует амплитуду и сбрасывает состояние
+func (gf *GoertzelFilter) processContinuous(input float64) {
+	q0 := gf.weight(input, gf.n) + gf.coeff*gf.q1 - gf.q2
+	gf.q2 = gf.q1
+	gf.q1 = q0
+	gf.n++
+
+	if gf.n == gf.totalN {
+		gf.latched, _ = gf.GetMagnitude()
+		gf.blocks++
+		gf.q1 = 0
+		gf.q2 = 0
+		gf.n = 0
+	}
+}
+
+// ProcessBlock обрабатывает блок отсчетов сигнала.
+// Оставшаяся емкость проверяется один раз до начала обработки: если блок
+// не помещается в totalN, состояние фильтра не изменяется.
+// В режиме автоперезапуска блок может быть любой длины и пересекать границы блоков анализа
+func (gf *GoertzelFilter) ProcessBlock(samples []float64) error {
+	if gf == nil {
+		return &InvalidStateError{Reason: "filter is not initialized"}
+	}
+
+	if gf.autoReset {
+		for _, input := range samples {
+			gf.processContinuous(input)
+		}
+		return nil
+	}
+
+	if len(samples) > gf.totalN-gf.n {
+		return &InvalidStateError{Reason: "block exceeds the remaining number of samples"}
+	}
+
+	q1, q2 := gf.q1, gf.q2
+	for i, input := range samples {
+		q0 := gf.weight(input, gf.n+i) + gf.coeff*q1 - q2
+		q2 = q1
+		q1 = q0
+	}
+	gf.q1, gf.q2 = q1, q2
+	gf.n += len(samples)
+
+	return nil
+}
+
 // Reset сбрасывает состояние фильтра для нового расчета
+// (в режиме автоперезапуска также очищает зафиксированную амплитуду)
 func (gf *GoertzelFilter) Reset() error {
 	if gf == nil {
 		return &InvalidStateError{Reason: "filter is not initialized"}
@@ -92,9 +227,34 @@ func (gf *GoertzelFilter) Reset() error {
 	gf.q1 = 0
 	gf.q2 = 0
 	gf.n = 0
+	gf.latched = 0
+	gf.blocks = 0
 	return nil
 }
 
+// LatchedMagnitude возвращает амплитуду последнего завершенного блока
+// в режиме автоперезапуска (NewGoertzelFilterAutoReset)
+func (gf *GoertzelFilter) LatchedMagnitude() (float64, error) {
+	if gf == nil {
+		return 0, &InvalidStateError{Reason: "filter is not initialized"}
+	}
+	if !gf.autoReset {
+		return 0, &InvalidStateError{Reason: "auto-reset mode is not enabled"}
+	}
+	if gf.blocks == 0 {
+		return 0, &InvalidStateError{Reason: "no block has been completed yet"}
+	}
+	return gf.latched, nil
+}
+
+// GetCompletedBlocks возвращает число завершенных блоков в режиме автоперезапуска
+func (gf *GoertzelFilter) GetCompletedBlocks() int {
+	if gf == nil {
+		return 0
+	}
+	return gf.blocks
+}
+
 // GetMagnitude возвращает амплитуду найденной частоты
 func (gf *GoertzelFilter) GetMagnitude() (float64, error) {
 	if gf == nil {
@@ -117,7 +277,8 @@ func (gf *GoertzelFilter) GetMagnitude() (float64, error) {
 	}
 
 	// Важно: здесь мы используем 2/float64(gf.totalN) для нормировки
-	magnitude := 2 * math.Sqrt(magnitudeSquared) / float64(gf.totalN)
+	// и компенсируем когерентное усиление окна
+	magnitude := 2 * math.Sqrt(magnitudeSquared) / (float64(gf.totalN) * gf.windowGain)
 
 	return magnitude, nil
 }
@@ -145,11 +306,45 @@ func (gf *GoertzelFilter) GetMagnitudeOptimized() (float64, error) {
 	}
 
 	// Нормировка такая же, как в GetMagnitude
-	magnitude := 2 * math.Sqrt(magnitudeSquared) / float64(gf.totalN)
+	magnitude := 2 * math.Sqrt(magnitudeSquared) / (float64(gf.totalN) * gf.windowGain)
 
 	return magnitude, nil
 }
 
+// GetMagnitudeDB возвращает амплитуду найденной частоты в децибелах: 20*log10(A).
+// Для нулевой амплитуды возвращается -Inf
+func (gf *GoertzelFilter) GetMagnitudeDB() (float64, error) {
+	magnitude, err := gf.GetMagnitude()
+	if err != nil {
+		return 0, err
+	}
+	return 20 * math.Log10(magnitude), nil
+}
+
+// SetWindowGain задает когерентное усиление окна (windows.CoherentGain),
+// которым взвешен входной сигнал. GetMagnitude, GetMagnitudeDB и GetPower
+// делят результат на это значение и возвращают истинную амплитуду тона.
+// Значение по умолчанию 1 соответствует прямоугольному окну
+func (gf *GoertzelFilter) SetWindowGain(gain float64) error {
+	if gf == nil {
+		return &InvalidStateError{Reason: "filter is not initialized"}
+	}
+	if gain <= 0 || gain > 1 || math.IsNaN(gain) {
+		return &InvalidParameterError{Param: "gain", Value: gain, Reason: "window coherent gain must be in range (0, 1]"}
+	}
+
+	gf.windowGain = gain
+	return nil
+}
+
+// GetWindowGain возвращает заданное когерентное усиление окна
+func (gf *GoertzelFilter) GetWindowGain() float64 {
+	if gf == nil {
+		return 0
+	}
+	return gf.windowGain
+}
+
 // GetPower возвращает мощность сигнала на целевой частоте
 func (gf *GoertzelFilter) GetPower() (float64, error) {
 	magnitude, err := gf.GetMagnitude()
@@ -159,6 +354,37 @@ func (gf *GoertzelFilter) GetPower() (float64, error) {
 	return magnitude * magnitude / 2, nil
 }
 
+// GetComplex возвращает комплексный коэффициент ДПФ X[k] (без нормировки 2/N)
+func (gf *GoertzelFilter) GetComplex() (complex128, error) {
+	if gf == nil {
+		return 0, &InvalidStateError{Reason: "filter is not initialized"}
+	}
+
+	if gf.n == 0 {
+		return 0, &InvalidStateError{Reason: "no samples have been processed yet"}
+	}
+
+	// Выход резонатора после последнего отсчета:
+	// y = q1 - q2*e^(-jw) = (q1 - q2*cos(w)) + j*q2*sin(w)
+	// Он отличается от X(w) = sum(x[n] * e^(-jwn)) множителем e^(jw(n-1)),
+	// поэтому домножаем на e^(-jw(n-1)), чтобы фаза соответствовала началу блока.
+	// Для целого k и n = N этот множитель равен e^(jw)
+	y := complex(gf.q1-gf.q2*gf.cosW, gf.q2*gf.sinW)
+	shift := -gf.w * float64(gf.n-1)
+
+	return y * complex(math.Cos(shift), math.Sin(shift)), nil
+}
+
+// GetPhase возвращает фазу найденной частоты в радианах (диапазон [-π, π])
+// относительно начала блока для косинусоиды
+func (gf *GoertzelFilter) GetPhase() (float64, error) {
+	x, err := gf.GetComplex()
+	if err != nil {
+		return 0, err
+	}
+	return cmplx.Phase(x), nil
+}
+
 // IsComplete возвращает true, если обработаны все выборки
 func (gf *GoertzelFilter) IsComplete() bool {
 	if gf == nil {
@@ -180,10 +406,14 @@ func (gf *GoertzelFilter) GetTargetFrequency(samplingRate float64) float64 {
 	if gf == nil || gf.totalN == 0 {
 		return 0
 	}
+	if gf.exact {
+		return gf.w * samplingRate / (2 * math.Pi)
+	}
 	return float64(gf.k) * samplingRate / float64(gf.totalN)
 }
 
 // GetCoefficient возвращает коэффициент k
+// (для точного режима - ближайший к целевой частоте бин)
 func (gf *GoertzelFilter) GetCoefficient() int {
 	if gf == nil {
 		return 0
@@ -346,6 +346,160 @@ func TestGoertzelFilter_MethodsConsistency(t *testing.T) {
 	t.Logf("Methods difference: %v", diff)
 }
 
+// TestGoertzelFilter_ComplexAndPhase проверяет комплексный выход и фазу
+func TestGoertzelFilter_ComplexAndPhase(t *testing.T) {
+	samplingRate := 8000.0
+	freq := 1000.0
+	totalN := 64
+
+	for _, phase := range []float64{0, math.Pi / 6, -math.Pi / 3, 2.5} {
+		t.Run(fmt.Sprintf("phase_%.2f", phase), func(t *testing.T) {
+			filter, err := NewGoertzelFilter(freq, samplingRate, totalN)
+			if err != nil {
+				t.Fatalf("failed to create filter: %v", err)
+			}
+
+			signal := make([]float64, totalN)
+			for i := range signal {
+				signal[i] = math.Cos(2*math.Pi*freq*float64(i)/samplingRate + phase)
+				_ = filter.Process(signal[i])
+			}
+
+			// Прямое ДПФ на бине k
+			w := 2 * math.Pi * float64(filter.GetCoefficient()) / float64(totalN)
+			var want complex128
+			for n, x := range signal {
+				want += complex(x*math.Cos(w*float64(n)), -x*math.Sin(w*float64(n)))
+			}
+
+			got, err := filter.GetComplex()
+			if err != nil {
+				t.Fatalf("failed to get complex output: %v", err)
+			}
+			if math.Abs(real(got)-real(want)) > 1e-9 || math.Abs(imag(got)-imag(want)) > 1e-9 {
+				t.Errorf("complex = %v, want %v", got, want)
+			}
+
+			gotPhase, err := filter.GetPhase()
+			if err != nil {
+				t.Fatalf("failed to get phase: %v", err)
+			}
+			if math.Abs(gotPhase-phase) > 1e-9 {
+				t.Errorf("phase = %v, want %v", gotPhase, phase)
+			}
+
+			// Модуль согласуется с GetMagnitude
+			magnitude, _ := filter.GetMagnitude()
+			if diff := math.Abs(2*math.Hypot(real(got), imag(got))/float64(totalN) - magnitude); diff > 1e-9 {
+				t.Errorf("complex magnitude inconsistent with GetMagnitude, diff = %v", diff)
+			}
+		})
+	}
+}
+
+// TestGoertzelFilter_ComplexNoSamples проверяет ошибку до обработки отсчетов
+func TestGoertzelFilter_ComplexNoSamples(t *testing.T) {
+	filter, err := NewGoertzelFilter(1000, 8000, 64)
+	if err != nil {
+		t.Fatalf("failed to create filter: %v", err)
+	}
+
+	if _, err := filter.GetComplex(); err == nil {
+		t.Error("expected error from GetComplex before processing")
+	} else if _, ok := err.(*InvalidStateError); !ok {
+		t.Errorf("expected InvalidStateError, got %T", err)
+	}
+	if _, err := filter.GetPhase(); err == nil {
+		t.Error("expected error from GetPhase before processing")
+	}
+}
+
+// TestGoertzelFilter_ExactOffBin проверяет точный режим для тона между бинами
+func TestGoertzelFilter_ExactOffBin(t *testing.T) {
+	samplingRate := 8000.0
+	totalN := 256
+	freq := 1015.625 // Ровно между бинами 32 и 33
+	amplitude := 1.0
+	phase := 0.7
+
+	standard, err := NewGoertzelFilter(freq, samplingRate, totalN)
+	if err != nil {
+		t.Fatalf("failed to create filter: %v", err)
+	}
+	exact, err := NewGoertzelFilterExact(freq, samplingRate, totalN)
+	if err != nil {
+		t.Fatalf("failed to create exact filter: %v", err)
+	}
+
+	for i := 0; i < totalN; i++ {
+		sample := amplitude * math.Cos(2*math.Pi*freq*float64(i)/samplingRate+phase)
+		_ = standard.Process(sample)
+		_ = exact.Process(sample)
+	}
+
+	standardMag, _ := standard.GetMagnitude()
+	exactMag, _ := exact.GetMagnitude()
+
+	if math.Abs(exactMag-amplitude) > 0.02 {
+		t.Errorf("exact magnitude = %v, want %v ± 0.02", exactMag, amplitude)
+	}
+	if math.Abs(standardMag-amplitude) < 0.2 {
+		t.Errorf("standard magnitude = %v is expected to suffer scalloping loss", standardMag)
+	}
+
+	exactPhase, _ := exact.GetPhase()
+	if math.Abs(exactPhase-phase) > 0.02 {
+		t.Errorf("exact phase = %v, want %v ± 0.02", exactPhase, phase)
+	}
+
+	if got := exact.GetTargetFrequency(samplingRate); math.Abs(got-freq) > 1e-9 {
+		t.Errorf("exact target frequency = %v, want %v", got, freq)
+	}
+}
+
+// TestGoertzelFilter_ProcessBlock проверяет совпадение блочной и поотсчетной обработки
+func TestGoertzelFilter_ProcessBlock(t *testing.T) {
+	totalN := 100
+	perSample, _ := NewGoertzelFilter(1000, 8000, totalN)
+	block, _ := NewGoertzelFilter(1000, 8000, totalN)
+
+	signal := make([]float64, totalN)
+	for i := range signal {
+		signal[i] = math.Sin(2*math.Pi*1000*float64(i)/8000) + 0.01*float64(i)
+	}
+
+	for _, s := range signal {
+		_ = perSample.Process(s)
+	}
+	if err := block.ProcessBlock(signal[:30]); err != nil {
+		t.Fatalf("failed to process block: %v", err)
+	}
+	if err := block.ProcessBlock(signal[30:]); err != nil {
+		t.Fatalf("failed to process block: %v", err)
+	}
+
+	if block.n != perSample.n || block.q1 != perSample.q1 || block.q2 != perSample.q2 {
+		t.Errorf("state mismatch: block (n=%d, q1=%v, q2=%v), per-sample (n=%d, q1=%v, q2=%v)",
+			block.n, block.q1, block.q2, perSample.n, perSample.q1, perSample.q2)
+	}
+
+	// Переполнение не должно изменять состояние
+	_ = block.Reset()
+	_ = block.ProcessBlock(signal[:90])
+	q1, q2 := block.q1, block.q2
+	if err := block.ProcessBlock(signal[:20]); err == nil {
+		t.Error("expected error when block exceeds totalN")
+	}
+	if block.n != 90 || block.q1 != q1 || block.q2 != q2 {
+		t.Error("state should not change on overflow")
+	}
+
+	var nilFilter *GoertzelFilter
+	if err := nilFilter.ProcessBlock(signal); err == nil {
+		t.Error("expected error for nil filter")
+	}
+}
+
 // Вспомогательная функция
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && (s[:len(substr)] == substr || contains(s[1:], substr)))
@@ -356,3 +510,35 @@ func BenchmarkSimple(b *testing.B) {
 		_ = i * i
 	}
 }
+
+// BenchmarkGoertzelProcess измеряет поотсчетную обработку блока из 4096 отсчетов
+func BenchmarkGoertzelProcess(b *testing.B) {
+	signal := make([]float64, 4096)
+	for i := range signal {
+		signal[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / 44100)
+	}
+	filter, _ := NewGoertzelFilter(1000, 44100, len(signal))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = filter.Reset()
+		for _, s := range signal {
+			_ = filter.Process(s)
+		}
+	}
+}
+
+// BenchmarkGoertzelProcessBlock измеряет блочную обработку 4096 отсчетов
+func BenchmarkGoertzelProcessBlock(b *testing.B) {
+	signal := make([]float64, 4096)
+	for i := range signal {
+		signal[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / 44100)
+	}
+	filter, _ := NewGoertzelFilter(1000, 44100, len(signal))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = filter.Reset()
+		_ = filter.ProcessBlock(signal)
+	}
+}
@@ -0,0 +1,208 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"dsp_go/pkg/windows"
+)
+
+// TestGoertzelFilter_WindowGainCorrection проверяет, что компенсация
+// когерентного усиления окна восстанавливает истинную амплитуду тона
+func TestGoertzelFilter_WindowGainCorrection(t *testing.T) {
+	const (
+		fs        = 8000.0
+		n         = 400
+		freq      = 1000.0 // Точно на бине k = 50
+		amplitude = 0.8
+	)
+
+	window := windows.Get(windows.BlackmanHarris)(n)
+	samples := make([]float64, n)
+	for i := range samples {
+		samples[i] = window[i] * amplitude * math.Sin(2*math.Pi*freq*float64(i)/fs)
+	}
+
+	gf, err := NewGoertzelFilter(freq, fs, n)
+	if err != nil {
+		t.Fatalf("Ошибка создания фильтра: %v", err)
+	}
+	if err := gf.ProcessBlock(samples); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+
+	// Без коррекции амплитуда занижена в CG раз
+	cg := windows.CoherentGain(window)
+	raw, _ := gf.GetMagnitude()
+	if math.Abs(raw-amplitude*cg) > 1e-3 {
+		t.Errorf("Без коррекции: ожидалось %f, получено %f", amplitude*cg, raw)
+	}
+
+	if err := gf.SetWindowGain(cg); err != nil {
+		t.Fatalf("Ошибка установки усиления окна: %v", err)
+	}
+	corrected, _ := gf.GetMagnitude()
+	if math.Abs(corrected-amplitude) > 1e-3 {
+		t.Errorf("С коррекцией: ожидалось %f, получено %f", amplitude, corrected)
+	}
+
+	db, err := gf.GetMagnitudeDB()
+	if err != nil {
+		t.Fatalf("Ошибка GetMagnitudeDB: %v", err)
+	}
+	if want := 20 * math.Log10(amplitude); math.Abs(db-want) > 0.01 {
+		t.Errorf("Амплитуда в дБ: ожидалось %f, получено %f", want, db)
+	}
+}
+
+// TestGoertzelFilter_WindowGainValidation проверяет проверку усиления окна
+// и ошибку GetMagnitudeDB до обработки отсчетов
+func TestGoertzelFilter_WindowGainValidation(t *testing.T) {
+	gf, _ := NewGoertzelFilter(1000, 8000, 100)
+
+	if gf.GetWindowGain() != 1 {
+		t.Errorf("Усиление по умолчанию: ожидалось 1, получено %f", gf.GetWindowGain())
+	}
+	for _, gain := range []float64{0, -0.5, 1.5, math.NaN()} {
+		if err := gf.SetWindowGain(gain); err == nil {
+			t.Errorf("Усиление %f: ожидалась ошибка", gain)
+		}
+	}
+
+	if _, err := gf.GetMagnitudeDB(); err == nil {
+		t.Error("GetMagnitudeDB без отсчетов: ожидалась ошибка")
+	}
+}
+
+// goertzelMagnitude обрабатывает блок и возвращает амплитуду
+func goertzelMagnitude(t *testing.T, gf *GoertzelFilter, samples []float64) float64 {
+	t.Helper()
+	if err := gf.ProcessBlock(samples); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+	magnitude, err := gf.GetMagnitude()
+	if err != nil {
+		t.Fatalf("Ошибка GetMagnitude: %v", err)
+	}
+	return magnitude
+}
+
+// TestGoertzelFilterWindowed_Selectivity сравнивает подавление соседнего тона
+// фильтром со встроенным окном Блэкмана-Харриса и фильтром без окна
+func TestGoertzelFilterWindowed_Selectivity(t *testing.T) {
+	const (
+		fs   = 8000.0
+		n    = 400    // Шаг бина 20 Гц
+		freq = 1000.0 // Бин k = 50
+	)
+	window := windows.Get(windows.BlackmanHarris)(n)
+
+	tone := func(f, amplitude float64) []float64 {
+		samples := make([]float64, n)
+		for i := range samples {
+			samples[i] = amplitude * math.Sin(2*math.Pi*f*float64(i)/fs)
+		}
+		return samples
+	}
+
+	// Тон на целевой частоте: коррекция усиления окна выполняется автоматически
+	windowed, err := NewGoertzelFilterWindowed(freq, fs, window)
+	if err != nil {
+		t.Fatalf("Ошибка создания фильтра: %v", err)
+	}
+	if got := goertzelMagnitude(t, windowed, tone(freq, 0.8)); math.Abs(got-0.8) > 1e-3 {
+		t.Errorf("Амплитуда тона: ожидалось 0.8, получено %f", got)
+	}
+	if math.Abs(windowed.GetWindowGain()-windows.CoherentGain(window)) > 1e-15 {
+		t.Errorf("Усиление окна: ожидалось %f, получено %f", windows.CoherentGain(window), windowed.GetWindowGain())
+	}
+
+	// Мешающий тон между бинами в 6.5 бинах от целевой частоты
+	interferer := tone(freq+6.5*fs/n, 1)
+
+	windowed.Reset()
+	withWindow := goertzelMagnitude(t, windowed, interferer)
+
+	plain, _ := NewGoertzelFilter(freq, fs, n)
+	withoutWindow := goertzelMagnitude(t, plain, interferer)
+
+	// Без окна утечка порядка 1/(π·6.5) ≈ -26 дБ, с окном ниже -90 дБ
+	if db := 20 * math.Log10(withoutWindow); db < -30 {
+		t.Errorf("Без окна: ожидалась утечка выше -30 дБ, получено %.1f дБ", db)
+	}
+	if db := 20 * math.Log10(withWindow); db > -90 {
+		t.Errorf("С окном: ожидалось подавление ниже -90 дБ, получено %.1f дБ", db)
+	}
+}
+
+// TestGoertzelFilterWindowed_ProcessMatchesBlock проверяет совпадение
+// поотсчетной и блочной обработки и отказ от пустого окна
+func TestGoertzelFilterWindowed_ProcessMatchesBlock(t *testing.T) {
+	window := windows.HannWindow(64)
+	samples := make([]float64, len(window))
+	for i := range samples {
+		samples[i] = math.Cos(0.7*float64(i)) + 0.2
+	}
+
+	bySample, _ := NewGoertzelFilterWindowed(1000, 8000, window)
+	for _, v := range samples {
+		if err := bySample.Process(v); err != nil {
+			t.Fatalf("Ошибка Process: %v", err)
+		}
+	}
+	byBlock, _ := NewGoertzelFilterWindowed(1000, 8000, window)
+	if err := byBlock.ProcessBlock(samples[:20]); err != nil {
+		t.Fatalf("Ошибка ProcessBlock: %v", err)
+	}
+	if err := byBlock.ProcessBlock(samples[20:]); err != nil {
+		t.Fatalf("Ошибка ProcessBlock: %v", err)
+	}
+
+	a, _ := bySample.GetComplex()
+	b, _ := byBlock.GetComplex()
+	if cmplx.Abs(a-b) > 1e-12 {
+		t.Errorf("Поотсчетная обработка %v, блочная %v", a, b)
+	}
+
+	if _, err := NewGoertzelFilterWindowed(1000, 8000, nil); err == nil {
+		t.Error("Пустое окно: ожидалась ошибка")
+	}
+}
+
+// TestGoertzelFilterWindowed_ScaledWindow проверяет, что окно с пиком выше
+// единицы принимается и амплитуда тона восстанавливается, а окно с нулевым
+// или нечисловым средним отвергается
+func TestGoertzelFilterWindowed_ScaledWindow(t *testing.T) {
+	const (
+		fs   = 8000.0
+		n    = 400
+		freq = 1000.0 // Бин k = 50
+	)
+	window := windows.Get(windows.BlackmanHarris)(n)
+	for i := range window {
+		window[i] *= 3 // Когерентное усиление ≈ 1.07
+	}
+
+	gf, err := NewGoertzelFilterWindowed(freq, fs, window)
+	if err != nil {
+		t.Fatalf("Окно с усилением %f: ошибка создания фильтра: %v", windows.CoherentGain(window), err)
+	}
+
+	samples := make([]float64, n)
+	for i := range samples {
+		samples[i] = 0.8 * math.Sin(2*math.Pi*freq*float64(i)/fs)
+	}
+	if got := goertzelMagnitude(t, gf, samples); math.Abs(got-0.8) > 1e-3 {
+		t.Errorf("Амплитуда тона: ожидалось 0.8, получено %f", got)
+	}
+
+	for name, bad := range map[string][]float64{
+		"нулевое": make([]float64, n),
+		"NaN":     {1, math.NaN(), 1},
+	} {
+		if _, err := NewGoertzelFilterWindowed(freq, fs, bad); err == nil {
+			t.Errorf("Окно %s: ожидалась ошибка", name)
+		}
+	}
+}
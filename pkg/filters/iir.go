@@ -18,7 +18,11 @@ type IIRFilter struct {
 	xPos int // Текущая позиция во входном буфере
 	yPos int // Текущая позиция в выходном буфере
 
+	tdf2State []float64 // Вектор состояния для транспонированной прямой формы II
+
 	order int // Порядок фильтра
+
+	sanitize bool // Замена NaN/Inf на входе нулем
 }
 
 // NewIIRFilter создает новый БИХ-фильтр с заданными коэффициентами
@@ -51,6 +55,8 @@ func NewIIRFilter(bCoeffs, aCoeffs []float64) *IIRFilter {
 		xBuffer: make([]float64, len(bCoeffs)),
 		yBuffer: make([]float64, len(aCoeffs)),
 		order:   order,
+
+		tdf2State: make([]float64, order),
 	}
 }
 
@@ -224,8 +230,79 @@ func NewSecondOrderBandPass(fc, Q float64) *IIRFilter {
 	return NewIIRFilter([]float64{b0, b1, b2}, []float64{1, a1, a2})
 }
 
+// NewSecondOrderNotch создает режекторный фильтр 2-го порядка
+// fc: частота подавления (0 < fc < 0.5)
+// Q: добротность (Q > 0), чем больше Q, тем уже полоса подавления
+func NewSecondOrderNotch(fc, Q float64) *IIRFilter {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if Q <= 0 {
+		panic("IIRFilter: Q must be positive")
+	}
+
+	w0 := 2.0 * math.Pi * fc
+	alpha := math.Sin(w0) / (2.0 * Q)
+
+	cosW0 := math.Cos(w0)
+
+	b0 := 1.0
+	b1 := -2.0 * cosW0
+	b2 := 1.0
+	a0 := 1.0 + alpha
+	a1 := -2.0 * cosW0
+	a2 := 1.0 - alpha
+
+	// Нормализуем коэффициенты
+	b0 /= a0
+	b1 /= a0
+	b2 /= a0
+	a1 /= a0
+	a2 /= a0
+
+	return NewIIRFilter([]float64{b0, b1, b2}, []float64{1, a1, a2})
+}
+
+// NewSecondOrderAllPass создает всепропускающий фильтр 2-го порядка
+// |H| = 1 на всех частотах, фаза меняется на 2π вокруг fc
+// fc: центральная частота (0 < fc < 0.5)
+// Q: добротность (Q > 0), чем больше Q, тем резче изменение фазы
+func NewSecondOrderAllPass(fc, Q float64) *IIRFilter {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if Q <= 0 {
+		panic("IIRFilter: Q must be positive")
+	}
+
+	w0 := 2.0 * math.Pi * fc
+	alpha := math.Sin(w0) / (2.0 * Q)
+
+	cosW0 := math.Cos(w0)
+
+	b0 := 1.0 - alpha
+	b1 := -2.0 * cosW0
+	b2 := 1.0 + alpha
+	a0 := 1.0 + alpha
+	a1 := -2.0 * cosW0
+	a2 := 1.0 - alpha
+
+	// Нормализуем коэффициенты
+	b0 /= a0
+	b1 /= a0
+	b2 /= a0
+	a1 /= a0
+	a2 /= a0
+
+	return NewIIRFilter([]float64{b0, b1, b2}, []float64{1, a1, a2})
+}
+
 // Tick применяет фильтр к одному новому отсчету
 func (f *IIRFilter) Tick(input float64) float64 {
+	if f.sanitize {
+		input = finiteOrZero(input)
+	}
+
 	// Сохраняем входной отсчет
 	f.xBuffer[f.xPos] = input
 
@@ -260,6 +337,69 @@ func (f *IIRFilter) Tick(input float64) float64 {
 	return output
 }
 
+// TickTDF2 применяет фильтр к одному новому отсчету в транспонированной
+// прямой форме II. Используется единый вектор состояния длины order, что
+// уменьшает накопление ошибок округления для фильтров с высокой добротностью.
+// Состояние этой формы независимо от буферов Tick, поэтому не следует
+// смешивать вызовы Tick и TickTDF2 на одном потоке данных
+func (f *IIRFilter) TickTDF2(input float64) float64 {
+	if f.sanitize {
+		input = finiteOrZero(input)
+	}
+
+	// y[n] = b0*x[n] + s0
+	output := f.bCoeffs[0]*input + f.stateAt(0)
+
+	// s[i] = b[i+1]*x[n] - a[i+1]*y[n] + s[i+1]
+	for i := 0; i < f.order; i++ {
+		f.tdf2State[i] = coeffAt(f.bCoeffs, i+1)*input -
+			coeffAt(f.aCoeffs, i+1)*output +
+			f.stateAt(i+1)
+	}
+
+	return output
+}
+
+// ProcessTDF2 обрабатывает весь срез входных данных в транспонированной прямой форме II
+func (f *IIRFilter) ProcessTDF2(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = f.TickTDF2(val)
+	}
+	return output
+}
+
+// stateAt возвращает элемент вектора состояния TDF-II (0 за его пределами)
+func (f *IIRFilter) stateAt(i int) float64 {
+	if i < len(f.tdf2State) {
+		return f.tdf2State[i]
+	}
+	return 0
+}
+
+// coeffAt возвращает коэффициент с индексом i (0 за пределами среза)
+func coeffAt(coeffs []float64, i int) float64 {
+	if i < len(coeffs) {
+		return coeffs[i]
+	}
+	return 0
+}
+
+// SetSanitize включает режим очистки входа: отсчеты NaN и ±Inf заменяются
+// нулем до попадания в буферы. Без этого режима один такой отсчет через
+// обратную связь навсегда делает выход фильтра NaN (до вызова Reset)
+func (f *IIRFilter) SetSanitize(enabled bool) {
+	f.sanitize = enabled
+}
+
+// finiteOrZero возвращает x для конечных значений и 0 для NaN и ±Inf
+func finiteOrZero(x float64) float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0
+	}
+	return x
+}
+
 // Reset сбрасывает состояние фильтра (очищает буферы)
 func (f *IIRFilter) Reset() {
 	for i := range f.xBuffer {
@@ -268,6 +408,9 @@ func (f *IIRFilter) Reset() {
 	for i := range f.yBuffer {
 		f.yBuffer[i] = 0
 	}
+	for i := range f.tdf2State {
+		f.tdf2State[i] = 0
+	}
 	f.xPos = 0
 	f.yPos = 0
 }
@@ -281,6 +424,47 @@ func (f *IIRFilter) Process(input []float64) []float64 {
 	return output
 }
 
+// ProcessInPlace фильтрует срез на месте, заменяя каждый отсчет выходным.
+// Состояние изменяется так же, как при Process, но без выделения памяти
+func (f *IIRFilter) ProcessInPlace(buf []float64) {
+	for i, val := range buf {
+		buf[i] = f.Tick(val)
+	}
+}
+
+// ImpulseResponse возвращает первые n отсчетов импульсной характеристики.
+// Расчет выполняется на новой копии фильтра, текущее состояние не изменяется
+func (f *IIRFilter) ImpulseResponse(n int) []float64 {
+	if n < 0 {
+		panic("IIRFilter: number of samples cannot be negative")
+	}
+
+	input := make([]float64, n)
+	if n > 0 {
+		input[0] = 1.0
+	}
+	return f.freshCopy().Process(input)
+}
+
+// StepResponse возвращает первые n отсчетов переходной характеристики.
+// Расчет выполняется на новой копии фильтра, текущее состояние не изменяется
+func (f *IIRFilter) StepResponse(n int) []float64 {
+	if n < 0 {
+		panic("IIRFilter: number of samples cannot be negative")
+	}
+
+	input := make([]float64, n)
+	for i := range input {
+		input[i] = 1.0
+	}
+	return f.freshCopy().Process(input)
+}
+
+// freshCopy создает копию фильтра с теми же коэффициентами и нулевым состоянием
+func (f *IIRFilter) freshCopy() *IIRFilter {
+	return NewIIRFilter(f.GetBCoeffs(), f.GetACoeffs())
+}
+
 // GetBCoeffs возвращает коэффициенты числителя
 func (f *IIRFilter) GetBCoeffs() []float64 {
 	return append([]float64{}, f.bCoeffs...)
@@ -296,6 +480,72 @@ func (f *IIRFilter) GetOrder() int {
 	return f.order
 }
 
+// DCGain возвращает усиление на нулевой частоте H(1) = sum(b) / sum(a).
+// Вычисляется точно в вещественной арифметике. Общие множители (1 - z^-1)
+// числителя и знаменателя (сокращающиеся нуль и полюс на DC) делятся
+// перед вычислением, поэтому, например, (1 - z^-1)/(1 - z^-1) дает 1.
+// Для несокращенного полюса на DC возвращается ±Inf
+func (f *IIRFilter) DCGain() float64 {
+	return gainAt(f.bCoeffs, f.aCoeffs, 1)
+}
+
+// NyquistGain возвращает усиление на частоте Найквиста
+// H(-1) = sum((-1)^i * b[i]) / sum((-1)^i * a[i]).
+// Общие множители (1 + z^-1) сокращаются так же, как в DCGain
+func (f *IIRFilter) NyquistGain() float64 {
+	return gainAt(f.bCoeffs, f.aCoeffs, -1)
+}
+
+// gainAt вычисляет B(z)/A(z) для z = ±1, сокращая общие множители (1 - z*z^-1)
+func gainAt(bCoeffs, aCoeffs []float64, z float64) float64 {
+	for len(bCoeffs) > 1 && len(aCoeffs) > 1 && hasRootAt(bCoeffs, z) && hasRootAt(aCoeffs, z) {
+		bCoeffs = deflateRoot(bCoeffs, z)
+		aCoeffs = deflateRoot(aCoeffs, z)
+	}
+
+	num := alternatingSum(bCoeffs, z)
+	if hasRootAt(aCoeffs, z) {
+		if hasRootAt(bCoeffs, z) {
+			return math.NaN() // Нулевой числитель
+		}
+		return math.Copysign(math.Inf(1), num)
+	}
+	return num / alternatingSum(aCoeffs, z)
+}
+
+// hasRootAt сообщает, обращается ли полином sum(coeffs[i] * z^-i) в нуль
+// в точке z = ±1 (с относительным допуском на ошибки округления)
+func hasRootAt(coeffs []float64, z float64) bool {
+	var scale float64
+	for _, c := range coeffs {
+		scale += math.Abs(c)
+	}
+	return math.Abs(alternatingSum(coeffs, z)) <= 1e-12*scale
+}
+
+// deflateRoot делит полином sum(coeffs[i] * z^-i) на (1 - z*z^-1) для z = ±1
+// синтетическим делением; остаток (нулевой при корне в z) отбрасывается
+func deflateRoot(coeffs []float64, z float64) []float64 {
+	quotient := make([]float64, len(coeffs)-1)
+	var carry float64
+	for i := range quotient {
+		carry = coeffs[i] + z*carry
+		quotient[i] = carry
+	}
+	return quotient
+}
+
+// alternatingSum вычисляет sum(coeffs[i] * z^-i) для z = ±1
+func alternatingSum(coeffs []float64, z float64) float64 {
+	var sum float64
+	sign := 1.0
+	for _, c := range coeffs {
+		sum += sign * c
+		sign *= z
+	}
+	return sum
+}
+
 // IsStable проверяет устойчивость фильтра (все полюса внутри единичной окружности)
 func (f *IIRFilter) IsStable() bool {
 	// Для проверки устойчивости нужно найти корни полинома знаменателя
@@ -323,26 +573,62 @@ func (f *IIRFilter) IsStable() bool {
 		return a2 < 1.0 && a2 > -1.0 && a2 > -a1-1.0 && a2 > a1-1.0
 	}
 
-	// Для более высоких порядков возвращаем true (нужна более сложная проверка)
+	// Для более высоких порядков используем критерий Шура-Кона
+	return isStableSchurCohn(f.aCoeffs)
+}
+
+// isStableSchurCohn проверяет, что все корни полинома
+// A(z) = 1 + a1*z^-1 + ... + aM*z^-M лежат внутри единичной окружности.
+// Используется рекурсия понижения порядка (обратная рекурсия Левинсона):
+// полином устойчив тогда и только тогда, когда все коэффициенты отражения |k_m| < 1
+func isStableSchurCohn(aCoeffs []float64) bool {
+	// Отбрасываем нулевые старшие коэффициенты
+	m := len(aCoeffs) - 1
+	for m > 0 && aCoeffs[m] == 0 {
+		m--
+	}
+
+	a := make([]float64, m+1)
+	for i := range a {
+		a[i] = aCoeffs[i] / aCoeffs[0]
+	}
+
+	next := make([]float64, m+1)
+	for ; m > 0; m-- {
+		k := a[m]
+		if math.Abs(k) >= 1.0 {
+			return false
+		}
+
+		// Понижаем порядок полинома: a'[i] = (a[i] - k*a[m-i]) / (1 - k^2)
+		denom := 1.0 - k*k
+		for i := 0; i < m; i++ {
+			next[i] = (a[i] - k*a[m-i]) / denom
+		}
+		a, next = next, a
+	}
+
 	return true
 }
 
 // GetFrequencyResponse вычисляет частотную характеристику на заданной частоте
+// H(e^jω) = B(z)/A(z) при z^-1 = e^(-jω), как у FIRFilter: фаза задерживающего
+// фильтра отрицательна
 func (f *IIRFilter) GetFrequencyResponse(freq float64) complex128 {
 	if freq < 0 || freq > 0.5 {
 		panic("frequency must be between 0 and 0.5 (Nyquist)")
 	}
 
-	// Вычисляем z = e^(j*2*pi*freq)
+	// Вычисляем z^-1 = e^(-j*2*pi*freq)
 	omega := 2.0 * math.Pi * freq
-	z := complex(math.Cos(omega), math.Sin(omega))
+	zInv := complex(math.Cos(omega), -math.Sin(omega))
 
 	// Вычисляем числитель H(z) = B(z)
 	var bSum complex128
 	zPower := complex(1, 0)
 	for _, b := range f.bCoeffs {
 		bSum += complex(b, 0) * zPower
-		zPower *= z
+		zPower *= zInv
 	}
 
 	// Вычисляем знаменатель A(z)
@@ -350,7 +636,7 @@ func (f *IIRFilter) GetFrequencyResponse(freq float64) complex128 {
 	zPower = complex(1, 0)
 	for _, a := range f.aCoeffs {
 		aSum += complex(a, 0) * zPower
-		zPower *= z
+		zPower *= zInv
 	}
 
 	// H(z) = B(z) / A(z)
@@ -0,0 +1,52 @@
+package filters
+
+import "math"
+
+// NewCombFilter создает гребенчатый фильтр с обратной связью:
+// y[n] = x[n] + feedback*y[n-delay], H(z) = 1 / (1 - feedback*z^-delay).
+// Знаменатель разреженный (ненулевые только a[0] и a[delay]), пики АЧХ
+// расположены с шагом fs/delay (при feedback < 0 - посередине между ними).
+// Применяется для синтеза звенящих тонов (алгоритм Карплуса-Стронга) и ревербераторов
+func NewCombFilter(delaySamples int, feedback float64) *IIRFilter {
+	if delaySamples < 1 {
+		panic("IIRFilter: comb delay must be at least 1 sample")
+	}
+	if math.Abs(feedback) >= 1 {
+		panic("IIRFilter: comb feedback magnitude must be less than 1")
+	}
+
+	aCoeffs := make([]float64, delaySamples+1)
+	aCoeffs[0] = 1
+	aCoeffs[delaySamples] = -feedback
+
+	return NewIIRFilter([]float64{1}, aCoeffs)
+}
+
+// NewResonator создает двухполюсный резонатор с единичным усилением на частоте fc.
+// Полюса расположены в r*e^(±j*2π*fc), радиус r = 1 - π*fc/Q задает полосу
+// пропускания fc/Q по уровню -3 дБ. Импульсная характеристика - затухающая
+// синусоида частоты fc
+// fc: резонансная частота (0 < fc < 0.5)
+// Q: добротность (Q > 0)
+func NewResonator(fc, Q float64) *IIRFilter {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if Q <= 0 {
+		panic("IIRFilter: Q must be positive")
+	}
+
+	r := 1 - math.Pi*fc/Q
+	if r <= 0 {
+		panic("IIRFilter: resonator bandwidth is too wide, increase Q")
+	}
+
+	w0 := 2.0 * math.Pi * fc
+	a1 := -2 * r * math.Cos(w0)
+	a2 := r * r
+
+	// |H(e^jw0)| = b0 / ((1-r) * |1 - r*e^(-2j*w0)|) = 1
+	b0 := (1 - r) * math.Sqrt(1-2*r*math.Cos(2*w0)+r*r)
+
+	return NewIIRFilter([]float64{b0}, []float64{1, a1, a2})
+}
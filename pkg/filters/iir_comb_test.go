@@ -0,0 +1,128 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// TestCombFilter_PeakSpacing проверяет, что пики АЧХ следуют с шагом fs/delay
+func TestCombFilter_PeakSpacing(t *testing.T) {
+	const (
+		delay    = 8
+		feedback = 0.7
+	)
+	comb := NewCombFilter(delay, feedback)
+
+	// Ищем локальные максимумы АЧХ на плотной сетке
+	const points = 4000
+	mags := make([]float64, points+1)
+	for i := range mags {
+		mags[i] = cmplx.Abs(comb.GetFrequencyResponse(0.5 * float64(i) / points))
+	}
+
+	var peaks []float64
+	for i := range mags {
+		left := i == 0 || mags[i] > mags[i-1]
+		right := i == points || mags[i] >= mags[i+1]
+		if left && right {
+			peaks = append(peaks, 0.5*float64(i)/points)
+		}
+	}
+
+	// Пики на частотах k/delay, k = 0..delay/2
+	if len(peaks) != delay/2+1 {
+		t.Fatalf("Ожидалось %d пиков, получено %d: %v", delay/2+1, len(peaks), peaks)
+	}
+	for k, f := range peaks {
+		if math.Abs(f-float64(k)/delay) > 1e-3 {
+			t.Errorf("Пик %d: ожидалась частота %f, получено %f", k, float64(k)/delay, f)
+		}
+	}
+
+	// Усиление в пиках 1/(1-g), в провалах 1/(1+g)
+	peakGain := cmplx.Abs(comb.GetFrequencyResponse(1.0 / delay))
+	if math.Abs(peakGain-1/(1-feedback)) > 1e-9 {
+		t.Errorf("Усиление в пике: ожидалось %f, получено %f", 1/(1-feedback), peakGain)
+	}
+	notchGain := cmplx.Abs(comb.GetFrequencyResponse(0.5 / delay))
+	if math.Abs(notchGain-1/(1+feedback)) > 1e-9 {
+		t.Errorf("Усиление в провале: ожидалось %f, получено %f", 1/(1+feedback), notchGain)
+	}
+}
+
+// TestCombFilter_ImpulseResponse проверяет затухающие эхо с периодом delay
+func TestCombFilter_ImpulseResponse(t *testing.T) {
+	comb := NewCombFilter(5, 0.5)
+	response := comb.ImpulseResponse(16)
+
+	for n, v := range response {
+		expected := 0.0
+		if n%5 == 0 {
+			expected = math.Pow(0.5, float64(n/5))
+		}
+		if math.Abs(v-expected) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", n, expected, v)
+		}
+	}
+}
+
+// TestResonator_RingsAtCenter проверяет звон импульсной характеристики на частоте fc
+func TestResonator_RingsAtCenter(t *testing.T) {
+	const fc = 0.05
+	res := NewResonator(fc, 50)
+
+	if gain := cmplx.Abs(res.GetFrequencyResponse(fc)); math.Abs(gain-1) > 1e-9 {
+		t.Errorf("Усиление на fc: ожидалось 1, получено %f", gain)
+	}
+	if !res.IsStable() {
+		t.Fatal("Резонатор должен быть устойчивым")
+	}
+
+	// Частота звона по числу переходов через ноль
+	response := res.ImpulseResponse(2000)
+	crossings := 0
+	first, last := -1, -1
+	for n := 1; n < len(response); n++ {
+		if (response[n-1] < 0) != (response[n] < 0) {
+			if first < 0 {
+				first = n
+			}
+			last = n
+			crossings++
+		}
+	}
+	ringFreq := float64(crossings-1) / 2 / float64(last-first)
+	if math.Abs(ringFreq-fc) > 1e-3 {
+		t.Errorf("Частота звона: ожидалось %f, получено %f", fc, ringFreq)
+	}
+
+	// Отклик затухает
+	if math.Abs(response[len(response)-1]) > 1e-3*math.Abs(response[10]) {
+		t.Errorf("Импульсная характеристика не затухает")
+	}
+}
+
+// TestCombFilter_InvalidParameters проверяет панику при некорректных параметрах
+func TestCombFilter_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Нулевая задержка", func() { NewCombFilter(0, 0.5) }},
+		{"Неустойчивая обратная связь", func() { NewCombFilter(4, 1) }},
+		{"Резонатор: широкая полоса", func() { NewResonator(0.4, 1) }},
+		{"Резонатор: нулевая добротность", func() { NewResonator(0.1, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package filters
+
+import "math"
+
+// Звенья параметрического эквалайзера (формулы RBJ Audio EQ Cookbook).
+// Во всех звеньях используется множитель усиления A = 10^(gainDB/40)
+
+// NewPeakingEQ создает пиковое (колокольное) звено эквалайзера
+// fc: центральная частота (0 < fc < 0.5)
+// Q: добротность (Q > 0)
+// gainDB: усиление на центральной частоте в дБ
+func NewPeakingEQ(fc, Q, gainDB float64) *IIRFilter {
+	validateEQParams(fc, Q)
+
+	A := math.Pow(10, gainDB/40)
+	w0 := 2.0 * math.Pi * fc
+	alpha := math.Sin(w0) / (2.0 * Q)
+
+	cosW0 := math.Cos(w0)
+
+	b0 := 1.0 + alpha*A
+	b1 := -2.0 * cosW0
+	b2 := 1.0 - alpha*A
+	a0 := 1.0 + alpha/A
+	a1 := -2.0 * cosW0
+	a2 := 1.0 - alpha/A
+
+	return NewIIRFilter([]float64{b0 / a0, b1 / a0, b2 / a0}, []float64{1, a1 / a0, a2 / a0})
+}
+
+// NewLowShelf создает полочное звено по нижним частотам
+// fc: частота перегиба (0 < fc < 0.5), на ней усиление равно gainDB/2
+// Q: добротность (Q > 0), Q = 0.707 дает монотонный переход
+// gainDB: усиление на нулевой частоте в дБ
+func NewLowShelf(fc, Q, gainDB float64) *IIRFilter {
+	validateEQParams(fc, Q)
+
+	A := math.Pow(10, gainDB/40)
+	w0 := 2.0 * math.Pi * fc
+	alpha := math.Sin(w0) / (2.0 * Q)
+
+	cosW0 := math.Cos(w0)
+	sqrtA2Alpha := 2.0 * math.Sqrt(A) * alpha
+
+	b0 := A * ((A + 1) - (A-1)*cosW0 + sqrtA2Alpha)
+	b1 := 2.0 * A * ((A - 1) - (A+1)*cosW0)
+	b2 := A * ((A + 1) - (A-1)*cosW0 - sqrtA2Alpha)
+	a0 := (A + 1) + (A-1)*cosW0 + sqrtA2Alpha
+	a1 := -2.0 * ((A - 1) + (A+1)*cosW0)
+	a2 := (A + 1) + (A-1)*cosW0 - sqrtA2Alpha
+
+	return NewIIRFilter([]float64{b0 / a0, b1 / a0, b2 / a0}, []float64{1, a1 / a0, a2 / a0})
+}
+
+// NewHighShelf создает полочное звено по верхним частотам
+// fc: частота перегиба (0 < fc < 0.5), на ней усиление равно gainDB/2
+// Q: добротность (Q > 0), Q = 0.707 дает монотонный переход
+// gainDB: усиление на частоте Найквиста в дБ
+func NewHighShelf(fc, Q, gainDB float64) *IIRFilter {
+	validateEQParams(fc, Q)
+
+	A := math.Pow(10, gainDB/40)
+	w0 := 2.0 * math.Pi * fc
+	alpha := math.Sin(w0) / (2.0 * Q)
+
+	cosW0 := math.Cos(w0)
+	sqrtA2Alpha := 2.0 * math.Sqrt(A) * alpha
+
+	b0 := A * ((A + 1) + (A-1)*cosW0 + sqrtA2Alpha)
+	b1 := -2.0 * A * ((A - 1) + (A+1)*cosW0)
+	b2 := A * ((A + 1) + (A-1)*cosW0 - sqrtA2Alpha)
+	a0 := (A + 1) - (A-1)*cosW0 + sqrtA2Alpha
+	a1 := 2.0 * ((A - 1) - (A+1)*cosW0)
+	a2 := (A + 1) - (A-1)*cosW0 - sqrtA2Alpha
+
+	return NewIIRFilter([]float64{b0 / a0, b1 / a0, b2 / a0}, []float64{1, a1 / a0, a2 / a0})
+}
+
+// validateEQParams проверяет частоту и добротность звена эквалайзера
+func validateEQParams(fc, Q float64) {
+	if fc <= 0 || fc >= 0.5 {
+		panic("IIRFilter: cutoff frequency must be between 0 and 0.5")
+	}
+	if Q <= 0 {
+		panic("IIRFilter: Q must be positive")
+	}
+}
@@ -0,0 +1,91 @@
+package filters
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// TestPeakingEQ проверяет усиление пикового звена
+func TestPeakingEQ(t *testing.T) {
+	fc := 0.05
+	for _, gain := range []float64{-12, -3, 0, 6, 15} {
+		t.Run(fmt.Sprintf("gain_%.0fdB", gain), func(t *testing.T) {
+			filter := NewPeakingEQ(fc, 1.4, gain)
+
+			if !filter.IsStable() {
+				t.Error("Звено эквалайзера должно быть устойчиво")
+			}
+			if got := gainDB(filter.GetFrequencyResponse(fc)); math.Abs(got-gain) > 1e-9 {
+				t.Errorf("Усиление на fc: ожидалось %.2f дБ, получено %.4f дБ", gain, got)
+			}
+
+			// Вне полосы усиление близко к 0 дБ
+			for _, freq := range []float64{0, 0.5} {
+				if got := gainDB(filter.GetFrequencyResponse(freq)); math.Abs(got) > 1e-9 {
+					t.Errorf("Усиление на %.2f: ожидалось 0 дБ, получено %.4f дБ", freq, got)
+				}
+			}
+		})
+	}
+}
+
+// TestShelfEQ проверяет асимптоты и усиление на частоте перегиба полочных звеньев
+func TestShelfEQ(t *testing.T) {
+	fc := 0.05
+	Q := 1 / math.Sqrt(2)
+	for _, gain := range []float64{-9, 6, 12} {
+		t.Run(fmt.Sprintf("gain_%.0fdB", gain), func(t *testing.T) {
+			low := NewLowShelf(fc, Q, gain)
+			high := NewHighShelf(fc, Q, gain)
+
+			if !low.IsStable() || !high.IsStable() {
+				t.Error("Полочные звенья должны быть устойчивы")
+			}
+
+			if got := gainDB(low.GetFrequencyResponse(0)); math.Abs(got-gain) > 1e-9 {
+				t.Errorf("НЧ-полка на DC: ожидалось %.2f дБ, получено %.4f дБ", gain, got)
+			}
+			if got := gainDB(low.GetFrequencyResponse(0.5)); math.Abs(got) > 1e-9 {
+				t.Errorf("НЧ-полка на Найквисте: ожидалось 0 дБ, получено %.4f дБ", got)
+			}
+			if got := gainDB(high.GetFrequencyResponse(0.5)); math.Abs(got-gain) > 1e-9 {
+				t.Errorf("ВЧ-полка на Найквисте: ожидалось %.2f дБ, получено %.4f дБ", gain, got)
+			}
+			if got := gainDB(high.GetFrequencyResponse(0)); math.Abs(got) > 1e-9 {
+				t.Errorf("ВЧ-полка на DC: ожидалось 0 дБ, получено %.4f дБ", got)
+			}
+
+			// На частоте перегиба усиление равно половине заданного
+			for name, filter := range map[string]*IIRFilter{"НЧ": low, "ВЧ": high} {
+				if got := gainDB(filter.GetFrequencyResponse(fc)); math.Abs(got-gain/2) > 1e-9 {
+					t.Errorf("%s-полка на fc: ожидалось %.2f дБ, получено %.4f дБ", name, gain/2, got)
+				}
+			}
+		})
+	}
+}
+
+// TestEQInvalidParams проверяет панику при неверных параметрах
+func TestEQInvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		create func()
+	}{
+		{"пиковое: нулевая частота", func() { NewPeakingEQ(0, 1, 6) }},
+		{"пиковое: нулевая добротность", func() { NewPeakingEQ(0.1, 0, 6) }},
+		{"НЧ-полка: частота Найквиста", func() { NewLowShelf(0.5, 0.7, 6) }},
+		{"ВЧ-полка: отрицательная добротность", func() { NewHighShelf(0.1, -1, 6) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.create()
+		})
+	}
+}
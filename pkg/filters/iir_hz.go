@@ -0,0 +1,48 @@
+package filters
+
+import "fmt"
+
+// Конструкторы БИХ-фильтров, принимающие частоты в герцах.
+// Нормировка fc = cutoffHz / sampleRateHz выполняется внутри
+
+// NewFirstOrderLowPassHz создает ФНЧ 1-го порядка по частоте среза в герцах
+func NewFirstOrderLowPassHz(cutoffHz, sampleRateHz float64) *IIRFilter {
+	return NewFirstOrderLowPass(normalizeCutoff(cutoffHz, sampleRateHz))
+}
+
+// NewFirstOrderHighPassHz создает ФВЧ 1-го порядка по частоте среза в герцах
+func NewFirstOrderHighPassHz(cutoffHz, sampleRateHz float64) *IIRFilter {
+	return NewFirstOrderHighPass(normalizeCutoff(cutoffHz, sampleRateHz))
+}
+
+// NewSecondOrderLowPassHz создает ФНЧ 2-го порядка по частоте среза в герцах
+func NewSecondOrderLowPassHz(cutoffHz, sampleRateHz, Q float64) *IIRFilter {
+	return NewSecondOrderLowPass(normalizeCutoff(cutoffHz, sampleRateHz), Q)
+}
+
+// NewSecondOrderHighPassHz создает ФВЧ 2-го порядка по частоте среза в герцах
+func NewSecondOrderHighPassHz(cutoffHz, sampleRateHz, Q float64) *IIRFilter {
+	return NewSecondOrderHighPass(normalizeCutoff(cutoffHz, sampleRateHz), Q)
+}
+
+// NewSecondOrderBandPassHz создает полосовой фильтр 2-го порядка по центральной частоте в герцах
+func NewSecondOrderBandPassHz(centerHz, sampleRateHz, Q float64) *IIRFilter {
+	return NewSecondOrderBandPass(normalizeCutoff(centerHz, sampleRateHz), Q)
+}
+
+// normalizeCutoff переводит частоту в герцах в нормированную (доли частоты дискретизации)
+func normalizeCutoff(cutoffHz, sampleRateHz float64) float64 {
+	if sampleRateHz <= 0 {
+		panic(fmt.Sprintf("IIRFilter: sample rate must be positive, got %g Hz", sampleRateHz))
+	}
+	if cutoffHz <= 0 {
+		panic(fmt.Sprintf("IIRFilter: cutoff frequency must be positive, got %g Hz", cutoffHz))
+	}
+	if cutoffHz >= sampleRateHz/2 {
+		panic(fmt.Sprintf(
+			"IIRFilter: cutoff frequency %g Hz must be less than Nyquist frequency %g Hz",
+			cutoffHz, sampleRateHz/2,
+		))
+	}
+	return cutoffHz / sampleRateHz
+}
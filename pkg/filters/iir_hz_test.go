@@ -0,0 +1,72 @@
+package filters
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestIIRFilterHzConstructors проверяет совпадение с нормированными конструкторами
+func TestIIRFilterHzConstructors(t *testing.T) {
+	fs := 48000.0
+	tests := []struct {
+		name string
+		hz   *IIRFilter
+		norm *IIRFilter
+	}{
+		{"ФНЧ 1-го порядка", NewFirstOrderLowPassHz(1000, fs), NewFirstOrderLowPass(1000 / fs)},
+		{"ФВЧ 1-го порядка", NewFirstOrderHighPassHz(200, fs), NewFirstOrderHighPass(200 / fs)},
+		{"ФНЧ 2-го порядка", NewSecondOrderLowPassHz(3000, fs, 0.707), NewSecondOrderLowPass(3000/fs, 0.707)},
+		{"ФВЧ 2-го порядка", NewSecondOrderHighPassHz(80, fs, 0.5), NewSecondOrderHighPass(80/fs, 0.5)},
+		{"Полосовой 2-го порядка", NewSecondOrderBandPassHz(1000, fs, 4), NewSecondOrderBandPass(1000/fs, 4)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareCoeffs(t, "b", tt.hz.GetBCoeffs(), tt.norm.GetBCoeffs())
+			compareCoeffs(t, "a", tt.hz.GetACoeffs(), tt.norm.GetACoeffs())
+		})
+	}
+}
+
+// compareCoeffs сравнивает два набора коэффициентов
+func compareCoeffs(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: ожидалось %d коэффициентов, получено %d", name, len(want), len(got))
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("%s[%d]: ожидалось %f, получено %f", name, i, want[i], got[i])
+		}
+	}
+}
+
+// TestIIRFilterHzInvalidParams проверяет сообщения паники при неверных частотах
+func TestIIRFilterHzInvalidParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  func()
+		message string
+	}{
+		{"частота на Найквисте", func() { NewFirstOrderLowPassHz(4000, 8000) }, "Nyquist"},
+		{"частота выше Найквиста", func() { NewSecondOrderLowPassHz(30000, 48000, 0.7) }, "Nyquist"},
+		{"нулевая частота", func() { NewFirstOrderHighPassHz(0, 8000) }, "positive"},
+		{"нулевая частота дискретизации", func() { NewSecondOrderBandPassHz(100, 0, 1) }, "sample rate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatal("Ожидалась паника")
+				}
+				if msg, ok := r.(string); !ok || !strings.Contains(msg, tt.message) {
+					t.Errorf("Сообщение паники %q не содержит %q", r, tt.message)
+				}
+			}()
+			tt.create()
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package filters
+
+// NewLeakyIntegrator создает интегратор с утечкой:
+// y[n] = x[n] + leak*y[n-1], H(z) = 1 / (1 - leak*z^-1).
+// Коэффициент передачи на нулевой частоте равен 1/(1-leak), постоянная
+// времени около 1/(1-leak) отсчетов. В отличие от идеального накопителя
+// (leak = 1) фильтр устойчив и забывает постоянную составляющую
+// leak: коэффициент утечки (0 < leak < 1)
+func NewLeakyIntegrator(leak float64) *IIRFilter {
+	if leak <= 0 || leak >= 1 {
+		panic("IIRFilter: leak must be between 0 and 1")
+	}
+
+	return NewIIRFilter([]float64{1}, []float64{1, -leak})
+}
@@ -0,0 +1,45 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestLeakyIntegrator_Step проверяет установившееся значение 1/(1-leak) для единичного скачка
+func TestLeakyIntegrator_Step(t *testing.T) {
+	for _, leak := range []float64{0.5, 0.9, 0.99} {
+		integrator := NewLeakyIntegrator(leak)
+
+		var y float64
+		prev := 0.0
+		for n := 0; n < 5000; n++ {
+			y = integrator.Tick(1)
+			if y < prev {
+				t.Fatalf("leak=%.2f, отсчет %d: выход убывает", leak, n)
+			}
+			prev = y
+		}
+
+		want := 1 / (1 - leak)
+		if math.Abs(y-want) > 1e-9*want {
+			t.Errorf("leak=%.2f: ожидалось %f, получено %f", leak, want, y)
+		}
+		if dc := integrator.DCGain(); math.Abs(dc-want) > 1e-9*want {
+			t.Errorf("leak=%.2f: DCGain %f, ожидалось %f", leak, dc, want)
+		}
+	}
+}
+
+// TestLeakyIntegrator_InvalidParams проверяет панику при неустойчивой утечке
+func TestLeakyIntegrator_InvalidParams(t *testing.T) {
+	for _, leak := range []float64{0, 1, -0.5, 1.5} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("leak=%.2f: ожидалась паника", leak)
+				}
+			}()
+			NewLeakyIntegrator(leak)
+		}()
+	}
+}
@@ -0,0 +1,42 @@
+package filters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// iirJSON - формат сериализации коэффициентов БИХ-фильтра
+type iirJSON struct {
+	B []float64 `json:"b"` // Коэффициенты числителя
+	A []float64 `json:"a"` // Коэффициенты знаменателя (a[0] = 1)
+}
+
+// MarshalJSON сериализует нормированные коэффициенты фильтра в JSON
+// вида {"b": [...], "a": [...]}. Состояние фильтра не сохраняется
+func (f *IIRFilter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(iirJSON{B: f.bCoeffs, A: f.aCoeffs})
+}
+
+// UnmarshalIIR восстанавливает фильтр из JSON, созданного MarshalJSON.
+// Возвращаемый фильтр находится в начальном (сброшенном) состоянии.
+// Числа с плавающей точкой сериализуются без потери точности, поэтому
+// частотная характеристика восстановленного фильтра совпадает с исходной
+func UnmarshalIIR(data []byte) (*IIRFilter, error) {
+	var coeffs iirJSON
+	if err := json.Unmarshal(data, &coeffs); err != nil {
+		return nil, fmt.Errorf("IIRFilter: decoding JSON: %w", err)
+	}
+
+	if len(coeffs.B) == 0 {
+		return nil, errors.New("IIRFilter: b coefficients cannot be empty")
+	}
+	if len(coeffs.A) == 0 {
+		return nil, errors.New("IIRFilter: a coefficients cannot be empty")
+	}
+	if coeffs.A[0] == 0 {
+		return nil, errors.New("IIRFilter: a[0] cannot be zero")
+	}
+
+	return NewIIRFilter(coeffs.B, coeffs.A), nil
+}
@@ -0,0 +1,63 @@
+package filters
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestIIRFilter_JSONRoundTrip проверяет точное восстановление частотной характеристики
+func TestIIRFilter_JSONRoundTrip(t *testing.T) {
+	original := NewSecondOrderBandPass(0.123, 3.7)
+
+	// Состояние фильтра не влияет на сериализацию
+	original.Process([]float64{1, 0.5, -0.25})
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Ошибка сериализации: %v", err)
+	}
+	if !strings.Contains(string(data), `"b":`) || !strings.Contains(string(data), `"a":`) {
+		t.Errorf("Неожиданный формат JSON: %s", data)
+	}
+
+	restored, err := UnmarshalIIR(data)
+	if err != nil {
+		t.Fatalf("Ошибка десериализации: %v", err)
+	}
+
+	for _, freq := range []float64{0, 0.05, 0.123, 0.2, 0.37, 0.5} {
+		if got, want := restored.GetFrequencyResponse(freq), original.GetFrequencyResponse(freq); got != want {
+			t.Errorf("Частота %.3f: ожидалось %v, получено %v", freq, want, got)
+		}
+	}
+
+	// Восстановленный фильтр находится в начальном состоянии
+	fresh := NewSecondOrderBandPass(0.123, 3.7)
+	for i, x := range []float64{1, 0, 0, 0.5} {
+		if got, want := restored.Tick(x), fresh.Tick(x); got != want {
+			t.Errorf("Отсчет %d: ожидалось %v, получено %v", i, want, got)
+		}
+	}
+}
+
+// TestUnmarshalIIR_Errors проверяет ошибки при некорректных данных
+func TestUnmarshalIIR_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"Некорректный JSON", `{"b": [1,`},
+		{"Пустой числитель", `{"b": [], "a": [1]}`},
+		{"Нет знаменателя", `{"b": [1]}`},
+		{"Нулевой a0", `{"b": [1], "a": [0, 0.5]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := UnmarshalIIR([]byte(tt.data)); err == nil {
+				t.Error("Ожидалась ошибка")
+			}
+		})
+	}
+}
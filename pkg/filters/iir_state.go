@@ -0,0 +1,42 @@
+package filters
+
+// FilterState - снимок внутреннего состояния IIRFilter для контрольных точек
+// детерминированного моделирования. Срезы являются копиями и не связаны с фильтром
+type FilterState struct {
+	XBuffer   []float64 // Буфер входных отсчетов
+	YBuffer   []float64 // Буфер выходных отсчетов
+	XPos      int       // Позиция во входном буфере
+	YPos      int       // Позиция в выходном буфере
+	TDF2State []float64 // Вектор состояния транспонированной прямой формы II
+}
+
+// SaveState возвращает копию текущего состояния фильтра
+func (f *IIRFilter) SaveState() FilterState {
+	return FilterState{
+		XBuffer:   append([]float64{}, f.xBuffer...),
+		YBuffer:   append([]float64{}, f.yBuffer...),
+		XPos:      f.xPos,
+		YPos:      f.yPos,
+		TDF2State: append([]float64{}, f.tdf2State...),
+	}
+}
+
+// RestoreState восстанавливает состояние, сохраненное SaveState.
+// После восстановления фильтр выдает побитово те же отсчеты, что и в момент
+// сохранения. Состояние должно быть получено от фильтра с той же структурой
+// коэффициентов, иначе возникает паника
+func (f *IIRFilter) RestoreState(state FilterState) {
+	if len(state.XBuffer) != len(f.xBuffer) || len(state.YBuffer) != len(f.yBuffer) ||
+		len(state.TDF2State) != len(f.tdf2State) {
+		panic("IIRFilter: state does not match filter structure")
+	}
+	if state.XPos < 0 || state.XPos >= len(f.xBuffer) || state.YPos < 0 || state.YPos >= len(f.yBuffer) {
+		panic("IIRFilter: state positions out of range")
+	}
+
+	copy(f.xBuffer, state.XBuffer)
+	copy(f.yBuffer, state.YBuffer)
+	copy(f.tdf2State, state.TDF2State)
+	f.xPos = state.XPos
+	f.yPos = state.YPos
+}
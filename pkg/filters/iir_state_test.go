@@ -0,0 +1,52 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestIIRFilter_SaveRestoreState проверяет побитовое совпадение продолжений
+// после восстановления сохраненного состояния
+func TestIIRFilter_SaveRestoreState(t *testing.T) {
+	input := make([]float64, 600)
+	for i := range input {
+		input[i] = math.Sin(0.03*float64(i)) + 0.4*math.Cos(0.9*float64(i))
+	}
+
+	for _, tdf2 := range []bool{false, true} {
+		filter := NewSecondOrderBandPass(0.1, 5)
+		process := filter.Process
+		if tdf2 {
+			process = filter.ProcessTDF2
+		}
+
+		process(input[:300])
+		state := filter.SaveState()
+
+		first := process(input[300:])
+
+		// Изменение снимка не влияет на фильтр и наоборот
+		filter.Reset()
+		filter.RestoreState(state)
+		state.YBuffer[0] = 1e9
+
+		second := process(input[300:])
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("TDF-II=%v, отсчет %d: %v != %v", tdf2, i, first[i], second[i])
+			}
+		}
+	}
+}
+
+// TestIIRFilter_RestoreStateMismatch проверяет панику для чужого состояния
+func TestIIRFilter_RestoreStateMismatch(t *testing.T) {
+	state := NewFirstOrderLowPass(0.1).SaveState()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника для состояния фильтра другого порядка")
+		}
+	}()
+	NewSecondOrderLowPass(0.1, 0.707).RestoreState(state)
+}
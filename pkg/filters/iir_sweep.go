@@ -0,0 +1,70 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+
+	"dsp_go/pkg/phaseutil"
+)
+
+// GroupDelaySweep вычисляет групповую задержку (в отсчетах) на numPoints (>= 2)
+// равноотстоящих нормированных частотах от 0 до 0.5 включительно
+func (f *IIRFilter) GroupDelaySweep(numPoints int) (freqs, delays []float64) {
+	freqs = sweepFrequencies(numPoints)
+	delays = make([]float64, numPoints)
+	for i, freq := range freqs {
+		delays[i] = f.GetGroupDelay(freq)
+	}
+	return freqs, delays
+}
+
+// PhaseSweep вычисляет развернутую фазовую характеристику (в радианах) на
+// numPoints (>= 2) равноотстоящих частотах от 0 до 0.5 включительно.
+// Скачки фазы между соседними точками больше π устраняются добавлением ±2π,
+// поэтому шаг сетки должен быть достаточно мелким для фильтров с резкой фазой.
+// Фаза причинного фильтра убывает: -dφ/dω равна GroupDelaySweep
+func (f *IIRFilter) PhaseSweep(numPoints int) (freqs, phases []float64) {
+	freqs = sweepFrequencies(numPoints)
+	phases = make([]float64, numPoints)
+	for i, freq := range freqs {
+		phases[i] = cmplx.Phase(f.GetFrequencyResponse(freq))
+	}
+	return freqs, phaseutil.Unwrap(phases)
+}
+
+// ResponseAt вычисляет частотную характеристику на произвольном наборе
+// нормированных частот (например, октавной сетке) и возвращает параллельные
+// срезы модуля, модуля в дБ и фазы в градусах (в диапазоне (-180, 180];
+// запаздывание по фазе отрицательно). Нулевой модуль дает -Inf дБ.
+// Все частоты проверяются до начала вычислений и должны лежать в [0, 0.5]
+func (f *IIRFilter) ResponseAt(freqs []float64) (mag, magDB, phaseDeg []float64) {
+	for _, freq := range freqs {
+		if freq < 0 || freq > 0.5 || math.IsNaN(freq) {
+			panic("IIRFilter: response frequencies must be between 0 and 0.5 (Nyquist)")
+		}
+	}
+
+	mag = make([]float64, len(freqs))
+	magDB = make([]float64, len(freqs))
+	phaseDeg = make([]float64, len(freqs))
+	for i, freq := range freqs {
+		response := f.GetFrequencyResponse(freq)
+		mag[i] = cmplx.Abs(response)
+		magDB[i] = 20 * math.Log10(mag[i])
+		phaseDeg[i] = cmplx.Phase(response) * 180 / math.Pi
+	}
+	return mag, magDB, phaseDeg
+}
+
+// sweepFrequencies возвращает numPoints равноотстоящих частот от 0 до 0.5
+func sweepFrequencies(numPoints int) []float64 {
+	if numPoints < 2 {
+		panic("IIRFilter: sweep must have at least 2 points")
+	}
+
+	freqs := make([]float64, numPoints)
+	for i := range freqs {
+		freqs[i] = 0.5 * float64(i) / float64(numPoints-1)
+	}
+	return freqs
+}
@@ -0,0 +1,131 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// TestIIRFilter_GroupDelaySweep проверяет, что групповая задержка
+// всепропускающего фильтра максимальна около его центральной частоты
+func TestIIRFilter_GroupDelaySweep(t *testing.T) {
+	const fc = 0.1
+	filter := NewSecondOrderAllPass(fc, 4)
+
+	freqs, delays := filter.GroupDelaySweep(501)
+	if len(freqs) != 501 || len(delays) != 501 {
+		t.Fatalf("Длины: %d частот, %d задержек", len(freqs), len(delays))
+	}
+	if freqs[0] != 0 || freqs[len(freqs)-1] != 0.5 {
+		t.Errorf("Диапазон частот: [%f, %f]", freqs[0], freqs[len(freqs)-1])
+	}
+
+	peak := 0
+	for i, d := range delays {
+		if d > delays[peak] {
+			peak = i
+		}
+		if d != filter.GetGroupDelay(freqs[i]) {
+			t.Fatalf("Точка %d не совпадает с GetGroupDelay", i)
+		}
+	}
+	if math.Abs(freqs[peak]-fc) > 0.01 {
+		t.Errorf("Максимум задержки на %f, ожидался около %f", freqs[peak], fc)
+	}
+}
+
+// TestIIRFilter_PhaseSweep проверяет монотонное убывание развернутой фазы
+// всепропускающего фильтра до -2π и согласованность с групповой задержкой
+func TestIIRFilter_PhaseSweep(t *testing.T) {
+	const points = 1001
+	filter := NewSecondOrderAllPass(0.1, 4)
+
+	freqs, phases := filter.PhaseSweep(points)
+	for i := 1; i < len(phases); i++ {
+		if phases[i] > phases[i-1]+1e-12 {
+			t.Fatalf("Фаза возрастает в точке %d: %f -> %f", i, phases[i-1], phases[i])
+		}
+		if math.Abs(phases[i]-phases[i-1]) > 0.5 {
+			t.Fatalf("Скачок фазы в точке %d: %f -> %f", i, phases[i-1], phases[i])
+		}
+	}
+
+	if math.Abs(phases[0]) > 1e-9 || math.Abs(phases[len(phases)-1]+2*math.Pi) > 1e-6 {
+		t.Errorf("Набег фазы: от %f до %f, ожидалось от 0 до -2π", phases[0], phases[len(phases)-1])
+	}
+
+	// Численная производная -dφ/dω совпадает с групповой задержкой
+	_, delays := filter.GroupDelaySweep(points)
+	for i := 1; i < len(phases)-1; i++ {
+		dOmega := 2 * math.Pi * (freqs[i+1] - freqs[i-1])
+		derivative := -(phases[i+1] - phases[i-1]) / dOmega
+		if math.Abs(derivative-delays[i]) > 1e-2*math.Max(1, delays[i]) {
+			t.Fatalf("f=%.4f: -dφ/dω = %f, групповая задержка %f", freqs[i], derivative, delays[i])
+		}
+	}
+}
+
+// TestIIRFilter_ResponseAt проверяет совпадение с отдельными вызовами GetFrequencyResponse
+func TestIIRFilter_ResponseAt(t *testing.T) {
+	filter := NewSecondOrderLowPass(0.1, 0.707)
+	freqs := []float64{0, 0.25, 0.5}
+
+	mag, magDB, phaseDeg := filter.ResponseAt(freqs)
+	if len(mag) != len(freqs) || len(magDB) != len(freqs) || len(phaseDeg) != len(freqs) {
+		t.Fatalf("Длины результатов: %d, %d, %d, ожидалось %d", len(mag), len(magDB), len(phaseDeg), len(freqs))
+	}
+
+	for i, freq := range freqs {
+		response := filter.GetFrequencyResponse(freq)
+		if want := cmplx.Abs(response); math.Abs(mag[i]-want) > 1e-12 {
+			t.Errorf("f=%.2f: модуль %g, ожидалось %g", freq, mag[i], want)
+		}
+		if want := 20 * math.Log10(cmplx.Abs(response)); math.Abs(magDB[i]-want) > 1e-9 && !(math.IsInf(want, -1) && math.IsInf(magDB[i], -1)) {
+			t.Errorf("f=%.2f: модуль %g дБ, ожидалось %g дБ", freq, magDB[i], want)
+		}
+		if want := cmplx.Phase(response) * 180 / math.Pi; math.Abs(phaseDeg[i]-want) > 1e-9 {
+			t.Errorf("f=%.2f: фаза %g°, ожидалось %g°", freq, phaseDeg[i], want)
+		}
+	}
+
+	// ФНЧ: единичное усиление на нулевой частоте, нуль на частоте Найквиста
+	if math.Abs(magDB[0]) > 1e-9 {
+		t.Errorf("Усиление на нулевой частоте: ожидалось 0 дБ, получено %g дБ", magDB[0])
+	}
+	if magDB[2] > -100 {
+		t.Errorf("Подавление на частоте Найквиста: ожидалось < -100 дБ, получено %g дБ", magDB[2])
+	}
+}
+
+// TestIIRFilter_ResponseAtPhaseSign проверяет абсолютный знак фазы
+func TestIIRFilter_ResponseAtPhaseSign(t *testing.T) {
+	// Задержка на один отсчет: фаза -ω, на f = 0.25 равна -90°
+	delay := NewIIRFilter([]float64{0, 1}, []float64{1})
+	_, _, phaseDeg := delay.ResponseAt([]float64{0.125, 0.25})
+	if math.Abs(phaseDeg[0]+45) > 1e-9 || math.Abs(phaseDeg[1]+90) > 1e-9 {
+		t.Errorf("Задержка: ожидалось -45° и -90°, получено %v", phaseDeg)
+	}
+
+	// ФНЧ 1-го порядка запаздывает по фазе во всей полосе
+	_, _, phaseDeg = NewFirstOrderLowPass(0.1).ResponseAt([]float64{0.05, 0.1, 0.3})
+	for i, p := range phaseDeg {
+		if p >= 0 {
+			t.Errorf("ФНЧ, точка %d: ожидалась отрицательная фаза, получено %f°", i, p)
+		}
+	}
+}
+
+// TestIIRFilter_ResponseAtInvalidFrequency проверяет панику при частоте вне [0, 0.5]
+func TestIIRFilter_ResponseAtInvalidFrequency(t *testing.T) {
+	filter := NewFirstOrderLowPass(0.2)
+	for _, freqs := range [][]float64{{0.1, 0.6}, {-0.1}, {0.2, math.NaN()}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v: ожидалась паника", freqs)
+				}
+			}()
+			filter.ResponseAt(freqs)
+		}()
+	}
+}
@@ -375,6 +375,50 @@ func TestIIRFilter_Stability(t *testing.T) {
 	}
 }
 
+// polyFromRoots возвращает коэффициенты [1, a1, ..., aM] полинома
+// знаменателя с заданными вещественными корнями (полюсами)
+func polyFromRoots(roots ...float64) []float64 {
+	coeffs := []float64{1}
+	for _, r := range roots {
+		next := make([]float64, len(coeffs)+1)
+		for i, c := range coeffs {
+			next[i] += c
+			next[i+1] -= r * c
+		}
+		coeffs = next
+	}
+	return coeffs
+}
+
+// TestIIRFilter_StabilityHighOrder проверяет устойчивость фильтров 3-го и более высоких порядков
+func TestIIRFilter_StabilityHighOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		aCoefs []float64
+		stable bool
+	}{
+		{"3-й порядок, полюса 0.5, 0.6, -0.7", polyFromRoots(0.5, 0.6, -0.7), true},
+		{"3-й порядок, полюс 1.2", polyFromRoots(0.5, 0.9, 1.2), false},
+		{"3-й порядок, полюс -1.05", polyFromRoots(-1.05, 0.1, 0.2), false},
+		{"3-й порядок, полюс на окружности", polyFromRoots(0.3, 1.0, -0.4), false},
+		{"5-й порядок, все полюса внутри", polyFromRoots(0.95, -0.9, 0.5, 0.1, -0.3), true},
+		{"6-й порядок, один полюс снаружи", polyFromRoots(0.9, 0.8, 0.7, -0.6, 0.2, 1.01), false},
+		// Пара комплексно-сопряженных полюсов радиуса 0.9 и вещественный 0.5
+		{"3-й порядок, комплексные полюса внутри", []float64{1, -0.5 - 2*0.9*math.Cos(1), 0.81 + 0.9*math.Cos(1), -0.5 * 0.81}, true},
+		// Пара комплексно-сопряженных полюсов радиуса 1.1 и вещественный 0.5
+		{"3-й порядок, комплексные полюса снаружи", []float64{1, -0.5 - 2*1.1*math.Cos(1), 1.21 + 1.1*math.Cos(1), -0.5 * 1.21}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewIIRFilter([]float64{1}, tt.aCoefs)
+			if got := filter.IsStable(); got != tt.stable {
+				t.Errorf("IsStable() = %v, ожидалось %v (a = %v)", got, tt.stable, tt.aCoefs)
+			}
+		})
+	}
+}
+
 // TestIIRFilter_FrequencyResponse проверяет вычисление частотной характеристики
 func TestIIRFilter_FrequencyResponse(t *testing.T) {
 	// Простой фильтр 1-го порядка
@@ -480,6 +524,283 @@ func TestIIRFilter_EmptyCoeffs(t *testing.T) {
 	_ = NewIIRFilter([]float64{0.5}, []float64{})
 }
 
+// TestIIRFilter_SecondOrderNotch проверяет режекторный фильтр 2-го порядка
+func TestIIRFilter_SecondOrderNotch(t *testing.T) {
+	// Подавление сетевой помехи 50 Гц при fs = 1000 Гц
+	fc := 50.0 / 1000.0
+	for _, Q := range []float64{0.707, 5, 30} {
+		filter := NewSecondOrderNotch(fc, Q)
+
+		if !filter.IsStable() {
+			t.Errorf("Q=%.1f: режекторный фильтр должен быть устойчив", Q)
+		}
+
+		// Точно на частоте подавления усиление близко к нулю
+		if gain := cmplx.Abs(filter.GetFrequencyResponse(fc)); gain > 1e-9 {
+			t.Errorf("Q=%.1f: усиление на fc: ожидалось ~0, получено %e", Q, gain)
+		}
+
+		// Вдали от частоты подавления усиление близко к 1
+		for _, freq := range []float64{0, 0.3, 0.5} {
+			if gain := cmplx.Abs(filter.GetFrequencyResponse(freq)); math.Abs(gain-1.0) > 0.05 {
+				t.Errorf("Q=%.1f: усиление на %.2f: ожидалось ~1, получено %f", Q, freq, gain)
+			}
+		}
+	}
+
+	// Синусоида на частоте подавления затухает после переходного процесса
+	filter := NewSecondOrderNotch(fc, 5)
+	var maxOut float64
+	for i := 0; i < 2000; i++ {
+		out := filter.Tick(math.Sin(2 * math.Pi * fc * float64(i)))
+		if i > 1500 {
+			maxOut = math.Max(maxOut, math.Abs(out))
+		}
+	}
+	if maxOut > 1e-3 {
+		t.Errorf("Остаток помехи после фильтрации: %e", maxOut)
+	}
+}
+
+// TestIIRFilter_SecondOrderAllPass проверяет всепропускающий фильтр 2-го порядка
+func TestIIRFilter_SecondOrderAllPass(t *testing.T) {
+	fc := 0.1
+	filter := NewSecondOrderAllPass(fc, 2.0)
+
+	if !filter.IsStable() {
+		t.Error("Всепропускающий фильтр должен быть устойчив")
+	}
+
+	// Модуль частотной характеристики равен 1 на всех частотах
+	peakFreq, peakDelay := 0.0, 0.0
+	for freq := 0.0; freq <= 0.5; freq += 0.001 {
+		gain := cmplx.Abs(filter.GetFrequencyResponse(freq))
+		if math.Abs(gain-1.0) > 1e-9 {
+			t.Errorf("Частота %.3f: ожидалось |H| = 1, получено %.12f", freq, gain)
+		}
+
+		if gd := filter.GetGroupDelay(freq); gd > peakDelay {
+			peakFreq, peakDelay = freq, gd
+		}
+	}
+
+	// Групповая задержка максимальна вблизи центральной частоты
+	if math.Abs(peakFreq-fc) > 0.01 {
+		t.Errorf("Пик групповой задержки: ожидался около %.2f, получено %.3f", fc, peakFreq)
+	}
+	if peakDelay <= filter.GetGroupDelay(0) || peakDelay <= filter.GetGroupDelay(0.5) {
+		t.Errorf("Групповая задержка на fc (%f) должна превышать задержку на краях диапазона", peakDelay)
+	}
+}
+
+// TestIIRFilter_TDF2MatchesDF1 проверяет совпадение транспонированной формы II с прямой формой I
+func TestIIRFilter_TDF2MatchesDF1(t *testing.T) {
+	filters := map[string]func() *IIRFilter{
+		"ФНЧ 1-го порядка": func() *IIRFilter { return NewFirstOrderLowPass(0.1) },
+		"ФНЧ 2-го порядка": func() *IIRFilter { return NewSecondOrderLowPass(0.15, 0.707) },
+		"b длиннее a":      func() *IIRFilter { return NewIIRFilter([]float64{0.5, 0.3, 0.2}, []float64{1, 0.1}) },
+		"a длиннее b":      func() *IIRFilter { return NewIIRFilter([]float64{0.5}, []float64{1, 0.2, 0.1, 0.05}) },
+	}
+
+	input := make([]float64, 200)
+	for i := range input {
+		input[i] = math.Sin(0.05*float64(i)) + 0.3*math.Cos(0.7*float64(i))
+	}
+
+	for name, create := range filters {
+		t.Run(name, func(t *testing.T) {
+			df1 := create().Process(input)
+			tdf2 := create().ProcessTDF2(input)
+			for i := range input {
+				if math.Abs(df1[i]-tdf2[i]) > 1e-12 {
+					t.Fatalf("Отсчет %d: DF-I = %v, TDF-II = %v", i, df1[i], tdf2[i])
+				}
+			}
+		})
+	}
+}
+
+// TestIIRFilter_TDF2HighQ проверяет энергию выхода резонатора с высокой добротностью
+func TestIIRFilter_TDF2HighQ(t *testing.T) {
+	fc := 0.01
+	filter := NewSecondOrderBandPass(fc, 50)
+
+	// На резонансной частоте усиление равно 1, поэтому в установившемся режиме
+	// средняя мощность выхода равна мощности синусоиды единичной амплитуды: 0.5
+	n := 200000
+	settle := 50000
+	var energy float64
+	for i := 0; i < n; i++ {
+		out := filter.TickTDF2(math.Sin(2 * math.Pi * fc * float64(i)))
+		if i >= settle {
+			energy += out * out
+		}
+	}
+	power := energy / float64(n-settle)
+
+	if math.Abs(power-0.5) > 1e-3 {
+		t.Errorf("Средняя мощность выхода: ожидалось 0.5, получено %f", power)
+	}
+
+	// После сброса состояние TDF-II обнуляется
+	filter.Reset()
+	if out := filter.TickTDF2(0); out != 0 {
+		t.Errorf("После Reset: ожидалось 0, получено %v", out)
+	}
+}
+
+// TestIIRFilter_ImpulseStepResponse проверяет импульсную и переходную характеристики
+func TestIIRFilter_ImpulseStepResponse(t *testing.T) {
+	filter := NewSecondOrderLowPass(0.05, 0.707)
+
+	// Переводим фильтр в ненулевое состояние
+	for i := 0; i < 10; i++ {
+		filter.Tick(float64(i))
+	}
+	xBefore := append([]float64{}, filter.xBuffer...)
+	yBefore := append([]float64{}, filter.yBuffer...)
+	xPos, yPos := filter.xPos, filter.yPos
+
+	n := 500
+	impulse := filter.ImpulseResponse(n)
+	step := filter.StepResponse(n)
+
+	if len(impulse) != n || len(step) != n {
+		t.Fatalf("Ожидалась длина %d, получено %d и %d", n, len(impulse), len(step))
+	}
+
+	// Первый отсчет импульсной характеристики равен b0
+	if math.Abs(impulse[0]-filter.GetBCoeffs()[0]) > 1e-12 {
+		t.Errorf("h[0]: ожидалось %f, получено %f", filter.GetBCoeffs()[0], impulse[0])
+	}
+
+	// Импульсная характеристика затухает
+	if math.Abs(impulse[n-1]) > 1e-9 {
+		t.Errorf("Импульсная характеристика не затухла: h[%d] = %e", n-1, impulse[n-1])
+	}
+
+	// Переходная характеристика устанавливается на уровне усиления на DC
+	dcGain := real(filter.GetFrequencyResponse(0))
+	if math.Abs(step[n-1]-dcGain) > 1e-9 {
+		t.Errorf("Установившееся значение: ожидалось %f, получено %f", dcGain, step[n-1])
+	}
+
+	// Переходная характеристика - накопленная сумма импульсной
+	var sum float64
+	for i := range impulse {
+		sum += impulse[i]
+		if math.Abs(step[i]-sum) > 1e-12 {
+			t.Fatalf("Отсчет %d: переходная %f, сумма импульсной %f", i, step[i], sum)
+		}
+	}
+
+	// Состояние исходного фильтра не изменилось
+	if filter.xPos != xPos || filter.yPos != yPos {
+		t.Error("Позиции буферов исходного фильтра изменились")
+	}
+	for i := range xBefore {
+		if filter.xBuffer[i] != xBefore[i] {
+			t.Errorf("xBuffer[%d] изменился", i)
+		}
+	}
+	for i := range yBefore {
+		if filter.yBuffer[i] != yBefore[i] {
+			t.Errorf("yBuffer[%d] изменился", i)
+		}
+	}
+
+	if len(filter.ImpulseResponse(0)) != 0 {
+		t.Error("ImpulseResponse(0) должен возвращать пустой срез")
+	}
+}
+
+// TestIIRFilter_ProcessInPlace проверяет совпадение с Process, в том числе
+// при обработке нескольких блоков подряд
+func TestIIRFilter_ProcessInPlace(t *testing.T) {
+	input := make([]float64, 300)
+	for i := range input {
+		input[i] = math.Sin(0.05*float64(i)) + 0.3*math.Cos(1.7*float64(i))
+	}
+
+	reference := NewSecondOrderLowPass(0.1, 0.707)
+	inPlace := NewSecondOrderLowPass(0.1, 0.707)
+
+	for start := 0; start < len(input); start += 100 {
+		block := input[start : start+100]
+		want := reference.Process(block)
+
+		buf := append([]float64{}, block...)
+		inPlace.ProcessInPlace(buf)
+		for i := range want {
+			if buf[i] != want[i] {
+				t.Fatalf("Отсчет %d: ожидалось %f, получено %f", start+i, want[i], buf[i])
+			}
+		}
+	}
+}
+
+// TestIIRFilter_DCAndNyquistGain проверяет усиление на DC и на частоте Найквиста
+func TestIIRFilter_DCAndNyquistGain(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      *IIRFilter
+		dc, nyquist float64
+		cancels     bool // Сокращение нуля и полюса: H(z) в особой точке не вычисляется напрямую
+	}{
+		{"ФНЧ 1-го порядка", NewFirstOrderLowPass(0.1), 1, 0, false},
+		{"ФВЧ 1-го порядка", NewFirstOrderHighPass(0.1), 0, 1, false},
+		{"ФНЧ 2-го порядка", NewSecondOrderLowPass(0.2, 0.707), 1, 0, false},
+		{"Гребенчатый, задержка 2", NewCombFilter(2, 0.5), 2, 2, false},
+		// Сокращение нуля и полюса: H = (1 - z^-1)(1 + 0.5z^-1) / ((1 - z^-1)(1 - 0.5z^-1))
+		{"Сокращение на DC", NewIIRFilter([]float64{1, -0.5, -0.5}, []float64{1, -1.5, 0.5}), 3, 1.0 / 3, true},
+		// H = (1 + z^-1)^2 / ((1 + z^-1)(1 - 0.25z^-1))
+		{"Сокращение на Найквисте", NewIIRFilter([]float64{1, 2, 1}, []float64{1, 0.75, -0.25}), 8.0 / 3, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.DCGain(); math.Abs(got-tt.dc) > 1e-12 {
+				t.Errorf("Усиление на DC: ожидалось %f, получено %f", tt.dc, got)
+			}
+			if got := tt.filter.NyquistGain(); math.Abs(got-tt.nyquist) > 1e-12 {
+				t.Errorf("Усиление на Найквисте: ожидалось %f, получено %f", tt.nyquist, got)
+			}
+
+			// Совпадение с комплексным расчетом
+			if tt.cancels {
+				return
+			}
+			if h := tt.filter.GetFrequencyResponse(0); math.Abs(real(h)-tt.filter.DCGain()) > 1e-9 {
+				t.Errorf("DCGain не совпадает с H(0) = %v", h)
+			}
+			if h := tt.filter.GetFrequencyResponse(0.5); math.Abs(real(h)-tt.filter.NyquistGain()) > 1e-9 {
+				t.Errorf("NyquistGain не совпадает с H(0.5) = %v", h)
+			}
+		})
+	}
+}
+
+// TestIIRFilter_DCAndNyquistGainSingular проверяет полное сокращение и настоящие полюсы
+func TestIIRFilter_DCAndNyquistGainSingular(t *testing.T) {
+	identity := NewIIRFilter([]float64{1, -1}, []float64{1, -1})
+	if got := identity.DCGain(); got != 1 {
+		t.Errorf("(1 - z^-1)/(1 - z^-1) на DC: ожидалось 1, получено %f", got)
+	}
+
+	// Накопитель y[n] = x[n] + y[n-1]: полюс на DC
+	if got := NewIIRFilter([]float64{1}, []float64{1, -1}).DCGain(); !math.IsInf(got, 1) {
+		t.Errorf("Полюс на DC: ожидалось +Inf, получено %f", got)
+	}
+	if got := NewIIRFilter([]float64{-1}, []float64{1, 1}).NyquistGain(); !math.IsInf(got, -1) {
+		t.Errorf("Полюс на Найквисте: ожидалось -Inf, получено %f", got)
+	}
+
+	// Двойной полюс и простой нуль на DC: после сокращения остается полюс
+	if got := NewIIRFilter([]float64{1, -1}, []float64{1, -2, 1}).DCGain(); !math.IsInf(got, 0) {
+		t.Errorf("Двойной полюс на DC: ожидалось ±Inf, получено %f", got)
+	}
+}
+
 // BenchmarkIIRFilter_Tick тестирует производительность БИХ-фильтра
 func BenchmarkIIRFilter_Tick(b *testing.B) {
 	// Фильтр 2-го порядка
@@ -499,9 +820,29 @@ func BenchmarkIIRFilter_Process(b *testing.B) {
 		input[i] = float64(i)
 	}
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		filter.Process(input)
 		filter.Reset()
 	}
 }
+
+// BenchmarkIIRFilter_ProcessInPlace тестирует обработку среза на месте
+// (сравните allocs/op с BenchmarkIIRFilter_Process при -benchmem)
+func BenchmarkIIRFilter_ProcessInPlace(b *testing.B) {
+	filter := NewSecondOrderLowPass(0.1, 0.707)
+	input := make([]float64, 1000)
+	buf := make([]float64, len(input))
+	for i := range input {
+		input[i] = float64(i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		filter.ProcessInPlace(buf)
+		filter.Reset()
+	}
+}
@@ -0,0 +1,97 @@
+package filters
+
+// interpolatorTapsPerFactor определяет длину ФНЧ интерполятора: 20*L+1 коэффициентов
+const interpolatorTapsPerFactor = 20
+
+// Interpolator повышает частоту дискретизации в целое число раз L.
+// Эквивалентен вставке L-1 нулей между отсчетами и фильтрации КИХ-ФНЧ
+// с частотой среза 0.5/L и усилением L, подавляющим зеркальные копии спектра.
+// Фильтр разложен на L полифазных составляющих, поэтому умножения
+// на вставленные нули не выполняются
+type Interpolator struct {
+	factor int         // Коэффициент интерполяции L
+	phases [][]float64 // Полифазные составляющие: phases[p][k] = h[k*L + p]
+	buffer []float64   // Кольцевой буфер входных отсчетов
+	pos    int         // Текущая позиция в буфере
+}
+
+// NewInterpolator создает интерполятор с коэффициентом factor (factor >= 1)
+func NewInterpolator(factor int) *Interpolator {
+	if factor < 1 {
+		panic("Interpolator: factor must be at least 1")
+	}
+
+	prototype := []float64{1}
+	if factor > 1 {
+		prototype = DesignLowPassFIR(0.5/float64(factor), interpolatorTapsPerFactor*factor+1, nil)
+	}
+
+	phases := polyphase(prototype, factor, float64(factor))
+
+	return &Interpolator{
+		factor: factor,
+		phases: phases,
+		buffer: make([]float64, len(phases[0])),
+		pos:    len(phases[0]) - 1,
+	}
+}
+
+// Process обрабатывает срез входных отсчетов и возвращает L выходных отсчетов
+// на каждый входной. Состояние сохраняется между вызовами
+func (ip *Interpolator) Process(input []float64) []float64 {
+	output := make([]float64, 0, len(input)*ip.factor)
+	n := len(ip.buffer)
+
+	for _, val := range input {
+		ip.pos++
+		if ip.pos == n {
+			ip.pos = 0
+		}
+		ip.buffer[ip.pos] = val
+
+		// y[m*L + p] = sum(h_p[k] * x[m-k])
+		for _, phase := range ip.phases {
+			var acc float64
+			bufIdx := ip.pos
+			for _, c := range phase {
+				acc += c * ip.buffer[bufIdx]
+				bufIdx--
+				if bufIdx < 0 {
+					bufIdx = n - 1
+				}
+			}
+			output = append(output, acc)
+		}
+	}
+
+	return output
+}
+
+// Reset сбрасывает состояние интерполятора
+func (ip *Interpolator) Reset() {
+	for i := range ip.buffer {
+		ip.buffer[i] = 0
+	}
+	ip.pos = len(ip.buffer) - 1
+}
+
+// GetFactor возвращает коэффициент интерполяции
+func (ip *Interpolator) GetFactor() int {
+	return ip.factor
+}
+
+// polyphase раскладывает прототип КИХ-фильтра на factor полифазных составляющих
+// одинаковой длины: phases[p][k] = gain * h[k*factor + p]
+func polyphase(prototype []float64, factor int, gain float64) [][]float64 {
+	phaseLen := (len(prototype) + factor - 1) / factor
+	phases := make([][]float64, factor)
+	for p := range phases {
+		phases[p] = make([]float64, phaseLen)
+		for k := 0; k < phaseLen; k++ {
+			if idx := k*factor + p; idx < len(prototype) {
+				phases[p][k] = prototype[idx] * gain
+			}
+		}
+	}
+	return phases
+}
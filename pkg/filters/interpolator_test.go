@@ -0,0 +1,70 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestInterpolator_NoImages проверяет сохранение амплитуды и подавление зеркальных копий
+func TestInterpolator_NoImages(t *testing.T) {
+	const (
+		fs     = 8000.0
+		factor = 4
+	)
+
+	input := make([]float64, 4000)
+	for i := range input {
+		input[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / fs)
+	}
+
+	output := NewInterpolator(factor).Process(input)
+	if len(output) != len(input)*factor {
+		t.Fatalf("Ожидалось %d отсчетов, получено %d", len(input)*factor, len(output))
+	}
+
+	// Зеркальные копии тона 1000 Гц находятся на k*8000 ± 1000 Гц
+	freqs := []float64{1000, 7000, 9000, 15000}
+	settled := output[400:]
+	bank, err := NewGoertzelBank(freqs, fs*factor, len(settled))
+	if err != nil {
+		t.Fatalf("Ошибка создания набора фильтров: %v", err)
+	}
+	if err := bank.ProcessBlock(settled); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+
+	mags := bank.Magnitudes()
+	if math.Abs(mags[0]-1) > 0.01 {
+		t.Errorf("Тон 1000 Гц: амплитуда %f, ожидалось 1", mags[0])
+	}
+	for i, mag := range mags[1:] {
+		if mag > 0.005 {
+			t.Errorf("Зеркальная копия %.0f Гц: амплитуда %f", freqs[i+1], mag)
+		}
+	}
+}
+
+// TestInterpolator_MatchesZeroStuffing проверяет совпадение с прямой фильтрацией
+// сигнала со вставленными нулями
+func TestInterpolator_MatchesZeroStuffing(t *testing.T) {
+	const factor = 3
+	input := []float64{1, -0.5, 0.25, 2, 0, -1, 0.75}
+
+	stuffed := make([]float64, len(input)*factor)
+	for i, v := range input {
+		stuffed[i*factor] = v
+	}
+	prototype := DesignLowPassFIR(0.5/factor, interpolatorTapsPerFactor*factor+1, nil)
+	for i := range prototype {
+		prototype[i] *= factor
+	}
+	expected := NewFIRFilter(prototype).Process(stuffed)
+
+	ip := NewInterpolator(factor)
+	got := append(ip.Process(input[:2]), ip.Process(input[2:])...)
+	for i := range expected {
+		if math.Abs(got[i]-expected[i]) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], got[i])
+		}
+	}
+}
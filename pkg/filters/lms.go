@@ -0,0 +1,113 @@
+package filters
+
+// LMSFilter - адаптивный КИХ-фильтр, коэффициенты которого подстраиваются
+// по алгоритму наименьших средних квадратов (LMS):
+//
+//	y[n] = sum(w[i] * x[n-i]),  e[n] = d[n] - y[n],  w[i] += mu * e[n] * x[n-i]
+//
+// Применяется для идентификации систем и компенсации коррелированного шума:
+// на вход подается опорный шум, в качестве желаемого сигнала - смесь
+// полезного сигнала и шума; ошибка e[n] сходится к полезному сигналу.
+// Для устойчивости шаг должен удовлетворять 0 < mu < 2 / (numTaps * P),
+// где P - мощность входного сигнала. Нормированный вариант (NewNLMSFilter)
+// не зависит от уровня входа
+type LMSFilter struct {
+	coeffs []float64  // Адаптируемые коэффициенты w
+	line   *DelayLine // Линия задержки входного сигнала
+	mu     float64    // Шаг адаптации
+
+	normalized bool    // Нормировка шага на энергию входного вектора (NLMS)
+	epsilon    float64 // Регуляризация знаменателя NLMS
+}
+
+// NewLMSFilter создает адаптивный фильтр с numTaps нулевыми коэффициентами
+// и шагом адаптации mu (mu > 0)
+func NewLMSFilter(numTaps int, mu float64) *LMSFilter {
+	if numTaps < 1 {
+		panic("LMSFilter: number of taps must be at least 1")
+	}
+	if mu <= 0 {
+		panic("LMSFilter: step size must be positive")
+	}
+
+	return &LMSFilter{
+		coeffs: make([]float64, numTaps),
+		line:   NewDelayLine(numTaps),
+		mu:     mu,
+	}
+}
+
+// NewNLMSFilter создает нормированный адаптивный фильтр (NLMS), в котором
+// шаг делится на энергию текущего входного вектора:
+//
+//	w[i] += mu * e[n] * x[n-i] / (epsilon + sum(x[n-k]^2))
+//
+// Скорость сходимости при этом не зависит от уровня входного сигнала, и
+// один шаг 0 < mu < 2 подходит для любых уровней, в том числе при резком
+// увеличении громкости. epsilon > 0 предотвращает деление на ноль в паузах
+func NewNLMSFilter(numTaps int, mu, epsilon float64) *LMSFilter {
+	if mu <= 0 || mu >= 2 {
+		panic("LMSFilter: NLMS step size must be in range (0, 2)")
+	}
+	if epsilon <= 0 {
+		panic("LMSFilter: regularization epsilon must be positive")
+	}
+
+	f := NewLMSFilter(numTaps, mu)
+	f.normalized = true
+	f.epsilon = epsilon
+	return f
+}
+
+// Adapt обрабатывает входной отсчет input при желаемом выходе desired,
+// возвращает выход фильтра и ошибку desired - output и обновляет коэффициенты
+func (f *LMSFilter) Adapt(input, desired float64) (output, errSignal float64) {
+	f.line.Push(input)
+	output = f.line.Dot(f.coeffs)
+	errSignal = desired - output
+
+	step := f.mu * errSignal
+	if f.normalized {
+		energy := f.epsilon
+		for i := range f.coeffs {
+			x := f.line.Get(i)
+			energy += x * x
+		}
+		step /= energy
+	}
+
+	for i := range f.coeffs {
+		f.coeffs[i] += step * f.line.Get(i)
+	}
+
+	return output, errSignal
+}
+
+// Tick вычисляет выход фильтра без адаптации коэффициентов
+func (f *LMSFilter) Tick(input float64) float64 {
+	f.line.Push(input)
+	return f.line.Dot(f.coeffs)
+}
+
+// Coefficients возвращает копию текущих коэффициентов
+func (f *LMSFilter) Coefficients() []float64 {
+	return append([]float64{}, f.coeffs...)
+}
+
+// Reset очищает линию задержки и обнуляет коэффициенты
+func (f *LMSFilter) Reset() {
+	f.line.Reset()
+	for i := range f.coeffs {
+		f.coeffs[i] = 0
+	}
+}
+
+// GetStepSize возвращает шаг адаптации
+func (f *LMSFilter) GetStepSize() float64 {
+	return f.mu
+}
+
+// IsNormalized сообщает, используется ли нормированный алгоритм (NLMS)
+func (f *LMSFilter) IsNormalized() bool {
+	return f.normalized
+}
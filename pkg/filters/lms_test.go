@@ -0,0 +1,139 @@
+package filters
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestLMSFilter_SystemIdentification проверяет, что фильтр находит
+// коэффициенты неизвестной КИХ-системы
+func TestLMSFilter_SystemIdentification(t *testing.T) {
+	unknown := []float64{0.5, -0.3, 0.2, 0.1}
+	system := NewFIRFilter(unknown)
+	lms := NewLMSFilter(len(unknown), 0.05)
+
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 5000; n++ {
+		x := rng.NormFloat64()
+		lms.Adapt(x, system.Tick(x))
+	}
+
+	coeffs := lms.Coefficients()
+	for i := range unknown {
+		if math.Abs(coeffs[i]-unknown[i]) > 1e-3 {
+			t.Errorf("Коэффициент %d: ожидалось %f, получено %f", i, unknown[i], coeffs[i])
+		}
+	}
+}
+
+// TestLMSFilter_NoiseCancellation проверяет подавление коррелированного шума:
+// ошибка сходится к полезному сигналу
+func TestLMSFilter_NoiseCancellation(t *testing.T) {
+	// Шум доходит до основного датчика через неизвестный тракт
+	path := NewFIRFilter([]float64{0.8, 0.4, -0.2})
+	lms := NewLMSFilter(8, 0.01)
+
+	rng := rand.New(rand.NewSource(2))
+	var residual float64
+	const n = 20000
+	for i := 0; i < n; i++ {
+		noise := rng.NormFloat64()
+		signal := 0.5 * math.Sin(2*math.Pi*0.01*float64(i))
+
+		_, e := lms.Adapt(noise, signal+path.Tick(noise))
+
+		// Остаточный шум на последней четверти записи
+		if i >= 3*n/4 {
+			residual += (e - signal) * (e - signal)
+		}
+	}
+	residual /= n / 4
+
+	// Мощность шума на датчике: 0.64 + 0.16 + 0.04 = 0.84
+	if residual > 0.84*1e-2 {
+		t.Errorf("Остаточная мощность шума %f, ожидалось подавление не менее 20 дБ", residual)
+	}
+}
+
+// TestLMSFilter_Reset проверяет сброс коэффициентов и параметры
+func TestLMSFilter_Reset(t *testing.T) {
+	lms := NewLMSFilter(3, 0.1)
+	lms.Adapt(1, 1)
+	if lms.Coefficients()[0] == 0 {
+		t.Fatal("Коэффициенты не адаптировались")
+	}
+
+	lms.Reset()
+	for i, c := range lms.Coefficients() {
+		if c != 0 {
+			t.Errorf("Коэффициент %d после Reset: %f", i, c)
+		}
+	}
+	if lms.GetStepSize() != 0.1 {
+		t.Errorf("Шаг: ожидалось 0.1, получено %f", lms.GetStepSize())
+	}
+}
+
+// identificationError возвращает среднеквадратичную ошибку коэффициентов
+// после адаптации на белом шуме с заданным уровнем
+func identificationError(f *LMSFilter, unknown []float64, scale float64, iterations int) float64 {
+	system := NewFIRFilter(unknown)
+	rng := rand.New(rand.NewSource(3))
+	for n := 0; n < iterations; n++ {
+		x := scale * rng.NormFloat64()
+		f.Adapt(x, system.Tick(x))
+	}
+
+	var sum float64
+	for i, c := range f.Coefficients() {
+		d := c - unknown[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum / float64(len(unknown)))
+}
+
+// TestNLMSFilter_ScaledInput проверяет, что NLMS сходится при любом уровне
+// входа, а LMS с тем же шагом расходится на громком сигнале
+func TestNLMSFilter_ScaledInput(t *testing.T) {
+	unknown := []float64{0.5, -0.3, 0.2, 0.1}
+
+	// Для LMS шаг 0.05 устойчив при единичной мощности, но не при мощности 100
+	if err := identificationError(NewLMSFilter(4, 0.05), unknown, 1, 2000); err > 1e-3 {
+		t.Errorf("LMS на единичном уровне: ошибка %g", err)
+	}
+	if err := identificationError(NewLMSFilter(4, 0.05), unknown, 10, 2000); !(math.IsNaN(err) || err > 1) {
+		t.Errorf("LMS на уровне 10 должен расходиться, ошибка %g", err)
+	}
+
+	// NLMS сходится одинаково быстро на всех уровнях
+	for _, scale := range []float64{0.01, 1, 10, 1000} {
+		err := identificationError(NewNLMSFilter(4, 0.5, 1e-9), unknown, scale, 500)
+		if err > 1e-6 {
+			t.Errorf("NLMS на уровне %g: ошибка %g", scale, err)
+		}
+	}
+}
+
+// TestNLMSFilter_SuddenLevelChange проверяет устойчивость при резком росте громкости
+func TestNLMSFilter_SuddenLevelChange(t *testing.T) {
+	system := NewFIRFilter([]float64{0.5, -0.3, 0.2, 0.1})
+	nlms := NewNLMSFilter(4, 0.5, 1e-6)
+	rng := rand.New(rand.NewSource(4))
+
+	for n := 0; n < 4000; n++ {
+		scale := 0.1
+		if n >= 2000 {
+			scale = 100
+		}
+		x := scale * rng.NormFloat64()
+		_, e := nlms.Adapt(x, system.Tick(x))
+		if math.IsNaN(e) || math.Abs(e) > 1000 {
+			t.Fatalf("Отсчет %d: ошибка %g, фильтр неустойчив", n, e)
+		}
+	}
+
+	if !nlms.IsNormalized() {
+		t.Error("Ожидался нормированный режим")
+	}
+}
@@ -0,0 +1,73 @@
+package filters
+
+import "sort"
+
+// MedianFilter представляет собой медианный фильтр с нечетной длиной окна.
+// В отличие от линейных фильтров, он полностью удаляет одиночные выбросы
+// длительностью менее половины окна. Отсортированная копия окна обновляется
+// при каждом отсчете двоичным поиском вместо полной сортировки.
+// Как и у КИХ-фильтра, окно изначально заполнено нулями.
+// Нечисловые отсчеты (NaN, ±Inf) заменяются нулем при поступлении
+// (как в режиме SetSanitize КИХ- и БИХ-фильтров): NaN нарушил бы порядок
+// отсортированного окна
+type MedianFilter struct {
+	buffer []float64 // Кольцевой буфер отсчетов окна в порядке поступления
+	sorted []float64 // Отсчеты окна в порядке возрастания
+	pos    int       // Позиция самого старого отсчета
+}
+
+// NewMedianFilter создает медианный фильтр с окном size отсчетов (нечетное, > 0)
+func NewMedianFilter(size int) *MedianFilter {
+	if size <= 0 || size%2 == 0 {
+		panic("MedianFilter: window size must be odd and positive")
+	}
+
+	return &MedianFilter{
+		buffer: make([]float64, size),
+		sorted: make([]float64, size),
+	}
+}
+
+// Tick применяет фильтр к одному новому отсчету и возвращает медиану окна
+func (m *MedianFilter) Tick(input float64) float64 {
+	input = finiteOrZero(input)
+
+	oldest := m.buffer[m.pos]
+	m.buffer[m.pos] = input
+	m.pos = (m.pos + 1) % len(m.buffer)
+
+	// Удаляем самый старый отсчет из отсортированного окна
+	idx := sort.SearchFloat64s(m.sorted, oldest)
+	copy(m.sorted[idx:], m.sorted[idx+1:])
+
+	// Вставляем новый отсчет с сохранением порядка
+	n := len(m.sorted) - 1
+	idx = sort.SearchFloat64s(m.sorted[:n], input)
+	copy(m.sorted[idx+1:], m.sorted[idx:n])
+	m.sorted[idx] = input
+
+	return m.sorted[len(m.sorted)/2]
+}
+
+// Process обрабатывает весь срез входных данных
+func (m *MedianFilter) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = m.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние фильтра
+func (m *MedianFilter) Reset() {
+	for i := range m.buffer {
+		m.buffer[i] = 0
+		m.sorted[i] = 0
+	}
+	m.pos = 0
+}
+
+// GetSize возвращает длину окна
+func (m *MedianFilter) GetSize() int {
+	return len(m.buffer)
+}
@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// naiveMedian возвращает медиану окна полной сортировкой копии
+func naiveMedian(window []float64) float64 {
+	sorted := append([]float64{}, window...)
+	sort.Float64s(sorted)
+	return sorted[len(sorted)/2]
+}
+
+// TestMedianFilter_RemovesSpike проверяет удаление выброса на линейном нарастании
+func TestMedianFilter_RemovesSpike(t *testing.T) {
+	const size = 5
+	input := make([]float64, 100)
+	for i := range input {
+		input[i] = 0.5 * float64(i)
+	}
+	input[50] = 1000 // Одиночный выброс
+
+	output := NewMedianFilter(size).Process(input)
+
+	// Медиана окна на нарастании - отсчет из середины окна (задержка (size-1)/2);
+	// пока выброс находится в окне, медиана смещается не более чем на один отсчет
+	delay := (size - 1) / 2
+	for i := size; i < len(output); i++ {
+		if expected := 0.5 * float64(i-delay); math.Abs(output[i]-expected) > 0.5 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected, output[i])
+		}
+	}
+}
+
+// TestMedianFilter_NonFiniteInput проверяет, что NaN и ±Inf не нарушают работу окна
+func TestMedianFilter_NonFiniteInput(t *testing.T) {
+	// NaN выходит из окна на 4-м отсчете после поступления
+	m := NewMedianFilter(3)
+	output := m.Process([]float64{1, math.NaN(), 2, 3, 4, 5})
+	expected := []float64{0, 0, 1, 2, 3, 4}
+	for i := range expected {
+		if output[i] != expected[i] {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], output[i])
+		}
+	}
+
+	// Выбросы NaN и ±Inf на нарастании подавляются как обычные импульсы
+	input := make([]float64, 60)
+	for i := range input {
+		input[i] = float64(i)
+	}
+	input[20], input[35], input[50] = math.NaN(), math.Inf(1), math.Inf(-1)
+
+	m = NewMedianFilter(5)
+	for i, v := range m.Process(input) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("Отсчет %d: нечисловой выход %f", i, v)
+		}
+		if i >= 5 && math.Abs(v-float64(i-2)) > 1 {
+			t.Errorf("Отсчет %d: ожидалось около %d, получено %f", i, i-2, v)
+		}
+	}
+}
+
+// TestMedianFilter_MatchesNaive проверяет совпадение с наивной сортировкой окна
+func TestMedianFilter_MatchesNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const size = 7
+
+	m := NewMedianFilter(size)
+	window := make([]float64, size)
+	for i := 0; i < 1000; i++ {
+		// Повторяющиеся значения проверяют удаление дубликатов
+		x := math.Round(rng.NormFloat64() * 3)
+		copy(window, window[1:])
+		window[size-1] = x
+
+		if got, want := m.Tick(x), naiveMedian(window); got != want {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, want, got)
+		}
+	}
+
+	m.Reset()
+	if got := m.Tick(5); got != 0 {
+		t.Errorf("После Reset: ожидалось 0, получено %f", got)
+	}
+}
+
+// TestMedianFilter_InvalidSize проверяет панику при четной длине окна
+func TestMedianFilter_InvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ожидалась паника при четной длине окна")
+		}
+	}()
+
+	_ = NewMedianFilter(4)
+}
+
+// BenchmarkMedianFilter измеряет производительность медианного фильтра с окном 101
+func BenchmarkMedianFilter(b *testing.B) {
+	m := NewMedianFilter(101)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Tick(math.Sin(float64(i)))
+	}
+}
+
+// BenchmarkMedianNaive измеряет производительность полной сортировки окна 101
+func BenchmarkMedianNaive(b *testing.B) {
+	window := make([]float64, 101)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(window, window[1:])
+		window[len(window)-1] = math.Sin(float64(i))
+		naiveMedian(window)
+	}
+}
@@ -0,0 +1,63 @@
+package filters
+
+// MovingAverage представляет собой фильтр скользящего среднего длины N.
+// Выход совпадает с КИХ-фильтром с коэффициентами 1/N, но вычисляется
+// через скользящую сумму (вычитание старого и добавление нового отсчета)
+// за O(1) операций на отсчет независимо от длины окна
+type MovingAverage struct {
+	buffer []float64 // Кольцевой буфер отсчетов окна
+	pos    int       // Позиция самого старого отсчета
+	sum    float64   // Скользящая сумма отсчетов окна
+}
+
+// NewMovingAverage создает фильтр скользящего среднего с окном length отсчетов
+func NewMovingAverage(length int) *MovingAverage {
+	if length <= 0 {
+		panic("MovingAverage: length must be positive")
+	}
+
+	return &MovingAverage{
+		buffer: make([]float64, length),
+	}
+}
+
+// Tick применяет фильтр к одному новому отсчету
+func (m *MovingAverage) Tick(input float64) float64 {
+	m.sum += input - m.buffer[m.pos]
+	m.buffer[m.pos] = input
+	m.pos++
+
+	// Раз за проход буфера пересчитываем сумму, чтобы не накапливать погрешность
+	if m.pos == len(m.buffer) {
+		m.pos = 0
+		m.sum = 0
+		for _, v := range m.buffer {
+			m.sum += v
+		}
+	}
+
+	return m.sum / float64(len(m.buffer))
+}
+
+// Process обрабатывает весь срез входных данных
+func (m *MovingAverage) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = m.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние фильтра
+func (m *MovingAverage) Reset() {
+	for i := range m.buffer {
+		m.buffer[i] = 0
+	}
+	m.pos = 0
+	m.sum = 0
+}
+
+// GetLength возвращает длину окна
+func (m *MovingAverage) GetLength() int {
+	return len(m.buffer)
+}
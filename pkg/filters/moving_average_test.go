@@ -0,0 +1,74 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// movingAverageCoeffs возвращает коэффициенты эквивалентного КИХ-фильтра
+func movingAverageCoeffs(n int) []float64 {
+	coeffs := make([]float64, n)
+	for i := range coeffs {
+		coeffs[i] = 1 / float64(n)
+	}
+	return coeffs
+}
+
+// TestMovingAverage_MatchesFIR проверяет совпадение с КИХ-фильтром 1/N
+func TestMovingAverage_MatchesFIR(t *testing.T) {
+	input := make([]float64, 5000)
+	for i := range input {
+		input[i] = 100 + math.Sin(0.05*float64(i)) + 0.3*math.Cos(1.3*float64(i))
+	}
+
+	for _, n := range []int{1, 4, 37, 256} {
+		ma := NewMovingAverage(n)
+		fir := NewFIRFilter(movingAverageCoeffs(n))
+
+		for i, val := range input {
+			got, want := ma.Tick(val), fir.Tick(val)
+			if math.Abs(got-want) > 1e-9 {
+				t.Fatalf("N=%d, отсчет %d: ожидалось %f, получено %f", n, i, want, got)
+			}
+		}
+	}
+}
+
+// TestMovingAverage_Reset проверяет сброс состояния
+func TestMovingAverage_Reset(t *testing.T) {
+	ma := NewMovingAverage(4)
+	first := ma.Process([]float64{4, 8, 12, 16, 20})
+
+	expected := []float64{1, 3, 6, 10, 14}
+	for i := range expected {
+		if math.Abs(first[i]-expected[i]) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидалось %f, получено %f", i, expected[i], first[i])
+		}
+	}
+
+	ma.Reset()
+	if got := ma.Tick(4); got != 1 {
+		t.Errorf("После Reset: ожидалось 1, получено %f", got)
+	}
+	if ma.GetLength() != 4 {
+		t.Errorf("Длина окна: ожидалось 4, получено %d", ma.GetLength())
+	}
+}
+
+// BenchmarkMovingAverage_1024 измеряет производительность скользящего среднего
+func BenchmarkMovingAverage_1024(b *testing.B) {
+	ma := NewMovingAverage(1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ma.Tick(float64(i))
+	}
+}
+
+// BenchmarkMovingAverageFIR_1024 измеряет производительность эквивалентного КИХ-фильтра
+func BenchmarkMovingAverageFIR_1024(b *testing.B) {
+	fir := NewFIRFilter(movingAverageCoeffs(1024))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fir.Tick(float64(i))
+	}
+}
@@ -0,0 +1,61 @@
+package filters
+
+// TickProcessor - звено, обрабатывающее сигнал поотсчетно.
+// Ему удовлетворяют FIRFilter, IIRFilter, BiquadCascade и другие фильтры пакета
+type TickProcessor interface {
+	Tick(input float64) float64
+	Reset()
+}
+
+// ParallelBank представляет собой параллельное соединение фильтров:
+// на все звенья подается один и тот же вход, выходы суммируются.
+// Подходит, например, для простого графического эквалайзера из полосовых фильтров
+type ParallelBank struct {
+	filters []TickProcessor // Параллельные звенья
+}
+
+// NewParallelBank создает параллельный банк из заданных звеньев
+func NewParallelBank(filters ...TickProcessor) *ParallelBank {
+	if len(filters) == 0 {
+		panic("ParallelBank: at least one filter is required")
+	}
+	for _, f := range filters {
+		if f == nil {
+			panic("ParallelBank: filter cannot be nil")
+		}
+	}
+
+	return &ParallelBank{
+		filters: append([]TickProcessor{}, filters...),
+	}
+}
+
+// Tick подает отсчет на все звенья и возвращает сумму их выходов
+func (pb *ParallelBank) Tick(input float64) float64 {
+	var output float64
+	for _, f := range pb.filters {
+		output += f.Tick(input)
+	}
+	return output
+}
+
+// Process обрабатывает весь срез входных данных
+func (pb *ParallelBank) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = pb.Tick(val)
+	}
+	return output
+}
+
+// Reset сбрасывает состояние всех звеньев
+func (pb *ParallelBank) Reset() {
+	for _, f := range pb.filters {
+		f.Reset()
+	}
+}
+
+// GetFilters возвращает копию среза звеньев банка
+func (pb *ParallelBank) GetFilters() []TickProcessor {
+	return append([]TickProcessor{}, pb.filters...)
+}
@@ -0,0 +1,75 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestParallelBank_ComplementaryReconstruction проверяет, что сумма ФНЧ и
+// комплементарного ФВЧ 1-го порядка восстанавливает исходный сигнал
+func TestParallelBank_ComplementaryReconstruction(t *testing.T) {
+	bank := NewParallelBank(NewFirstOrderLowPass(0.1), NewFirstOrderHighPass(0.1))
+
+	input := make([]float64, 1000)
+	for i := range input {
+		input[i] = math.Sin(0.02*float64(i)) + 0.5*math.Sin(1.9*float64(i))
+	}
+
+	output := bank.Process(input)
+	for i := range input {
+		if math.Abs(output[i]-input[i]) > 1e-9 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, input[i], output[i])
+		}
+	}
+}
+
+// TestParallelBank_SumOfBands проверяет, что выход банка равен сумме выходов звеньев
+func TestParallelBank_SumOfBands(t *testing.T) {
+	freqs := []float64{0.05, 0.15, 0.3}
+	bank := NewParallelBank(
+		NewSecondOrderBandPass(freqs[0], 2),
+		NewSecondOrderBandPass(freqs[1], 2),
+		NewSecondOrderBandPass(freqs[2], 2),
+	)
+	references := make([]*IIRFilter, len(freqs))
+	for i, fc := range freqs {
+		references[i] = NewSecondOrderBandPass(fc, 2)
+	}
+
+	for n := 0; n < 500; n++ {
+		input := math.Sin(0.3*float64(n)) + math.Cos(1.1*float64(n))
+
+		var want float64
+		for _, ref := range references {
+			want += ref.Tick(input)
+		}
+
+		if got := bank.Tick(input); math.Abs(got-want) > 1e-12 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", n, want, got)
+		}
+	}
+}
+
+// TestParallelBank_Reset проверяет сброс состояния всех звеньев
+func TestParallelBank_Reset(t *testing.T) {
+	bank := NewParallelBank(NewFIRFilter([]float64{0.5, 0.5}), NewMovingAverage(3))
+	first := bank.Process([]float64{3, 6, 9, 12})
+
+	bank.Reset()
+	second := bank.Process([]float64{3, 6, 9, 12})
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("Отсчет %d после Reset: ожидалось %f, получено %f", i, first[i], second[i])
+		}
+	}
+}
+
+// TestParallelBank_Empty проверяет панику без звеньев
+func TestParallelBank_Empty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника для пустого банка")
+		}
+	}()
+	NewParallelBank()
+}
@@ -0,0 +1,242 @@
+package filters
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	remezGridDensity   = 16   // Плотность частотной сетки (точек на экстремум)
+	remezMaxIterations = 100  // Максимальное число итераций обмена
+	remezTolerance     = 1e-6 // Допустимая неравномерность экстремумов ошибки
+)
+
+// RemezLowPass рассчитывает КИХ-ФНЧ с равноволновой (чебышевской) характеристикой
+// алгоритмом Паркса-МакКлеллана (обмен Ремеза). numTaps - нечетная длина фильтра
+// (тип I, линейная фаза), passEdge и stopEdge - границы полосы пропускания и
+// задерживания в долях частоты дискретизации (0 < passEdge < stopEdge < 0.5),
+// passWeight и stopWeight - веса ошибки в полосах. Отношение пульсаций в полосах
+// обратно пропорционально отношению весов: δp/δs = stopWeight/passWeight
+func RemezLowPass(numTaps int, passEdge, stopEdge float64, passWeight, stopWeight float64) ([]float64, error) {
+	if numTaps < 3 || numTaps%2 == 0 {
+		return nil, fmt.Errorf("RemezLowPass: number of taps must be odd and at least 3, got %d", numTaps)
+	}
+	if passEdge <= 0 || stopEdge >= 0.5 || passEdge >= stopEdge {
+		return nil, errors.New("RemezLowPass: band edges must satisfy 0 < passEdge < stopEdge < 0.5")
+	}
+	if passWeight <= 0 || stopWeight <= 0 {
+		return nil, errors.New("RemezLowPass: weights must be positive")
+	}
+
+	// Число косинусных коэффициентов и экстремумов
+	half := (numTaps - 1) / 2
+	r := half + 1
+
+	grid, desired, weight := remezGrid(r, passEdge, stopEdge, passWeight, stopWeight)
+	if len(grid) < r+1 {
+		return nil, errors.New("RemezLowPass: frequency grid is too coarse")
+	}
+
+	// Начальные экстремумы равномерно распределены по сетке
+	extremal := make([]int, r+1)
+	for k := range extremal {
+		extremal[k] = k * (len(grid) - 1) / r
+	}
+
+	gridX := make([]float64, len(grid))
+	for i, f := range grid {
+		gridX[i] = math.Cos(2 * math.Pi * f)
+	}
+
+	var response func(x float64) float64
+	converged := false
+	errs := make([]float64, len(grid))
+
+	for iter := 0; iter < remezMaxIterations; iter++ {
+		var delta float64
+		response, delta = remezInterpolate(extremal, gridX, desired, weight)
+
+		for i, x := range gridX {
+			errs[i] = weight[i] * (desired[i] - response(x))
+		}
+
+		next, ok := remezExtrema(errs, grid, stopEdge, r+1)
+		if !ok {
+			return nil, errors.New("RemezLowPass: failed to find alternating extrema")
+		}
+
+		// Сходимость: все экстремумы ошибки равны |delta|
+		maxErr := 0.0
+		for _, idx := range next {
+			maxErr = math.Max(maxErr, math.Abs(errs[idx]))
+		}
+		extremal = next
+		if (maxErr-math.Abs(delta))/maxErr < remezTolerance {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		return nil, errors.New("RemezLowPass: exchange algorithm did not converge")
+	}
+
+	// Коэффициенты по отсчетам A(ω) на равномерной сетке из numTaps точек:
+	// h[n] = (A(0) + 2*sum(A(2πm/N)*cos(2πm(n-L)/N))) / N
+	samples := make([]float64, half+1)
+	for m := range samples {
+		samples[m] = response(math.Cos(2 * math.Pi * float64(m) / float64(numTaps)))
+	}
+
+	coeffs := make([]float64, numTaps)
+	for n := range coeffs {
+		sum := samples[0]
+		for m := 1; m <= half; m++ {
+			sum += 2 * samples[m] * math.Cos(2*math.Pi*float64(m*(n-half))/float64(numTaps))
+		}
+		coeffs[n] = sum / float64(numTaps)
+	}
+
+	return coeffs, nil
+}
+
+// remezGrid строит плотную частотную сетку по полосам пропускания и задерживания
+// с желаемой характеристикой и весами в каждой точке
+func remezGrid(r int, passEdge, stopEdge, passWeight, stopWeight float64) (grid, desired, weight []float64) {
+	step := 0.5 / float64(remezGridDensity*r)
+
+	addBand := func(low, high, d, w float64) {
+		count := int(math.Ceil((high-low)/step)) + 1
+		for i := 0; i < count; i++ {
+			f := low + (high-low)*float64(i)/float64(count-1)
+			grid = append(grid, f)
+			desired = append(desired, d)
+			weight = append(weight, w)
+		}
+	}
+
+	addBand(0, passEdge, 1, passWeight)
+	addBand(stopEdge, 0.5, 0, stopWeight)
+	return grid, desired, weight
+}
+
+// remezInterpolate по текущим экстремумам вычисляет отклонение delta и
+// возвращает функцию A(x), x = cos(ω), интерполирующую D - (-1)^k*delta/W
+// в экстремальных точках (барицентрическая форма Лагранжа)
+func remezInterpolate(extremal []int, gridX, desired, weight []float64) (func(x float64) float64, float64) {
+	n := len(extremal)
+	x := make([]float64, n)
+	for k, idx := range extremal {
+		x[k] = gridX[idx]
+	}
+
+	// Отклонение delta по всем r+1 точкам
+	b := lagrangeWeights(x)
+	var num, den float64
+	sign := 1.0
+	for k, idx := range extremal {
+		num += b[k] * desired[idx]
+		den += sign * b[k] / weight[idx]
+		sign = -sign
+	}
+	delta := num / den
+
+	// Интерполяция по первым r точкам
+	xi := x[:n-1]
+	d := lagrangeWeights(xi)
+	c := make([]float64, n-1)
+	sign = 1.0
+	for k := range c {
+		idx := extremal[k]
+		c[k] = desired[idx] - sign*delta/weight[idx]
+		sign = -sign
+	}
+
+	response := func(xv float64) float64 {
+		var numer, denom float64
+		for k, xk := range xi {
+			diff := xv - xk
+			if math.Abs(diff) < 1e-14 {
+				return c[k]
+			}
+			t := d[k] / diff
+			numer += t * c[k]
+			denom += t
+		}
+		return numer / denom
+	}
+
+	return response, delta
+}
+
+// lagrangeWeights вычисляет барицентрические веса 1/prod(x_k - x_j).
+// Множители перемножаются с прореживанием и удвоением (как в программе
+// Паркса-МакКлеллана), чтобы избежать переполнения и потери точности
+func lagrangeWeights(x []float64) []float64 {
+	n := len(x)
+	step := (n-2)/15 + 1
+	if step < 1 {
+		step = 1
+	}
+
+	weights := make([]float64, n)
+	for k := range x {
+		denom := 1.0
+		for j := 0; j < step; j++ {
+			for l := j; l < n; l += step {
+				if l != k {
+					denom *= 2 * (x[k] - x[l])
+				}
+			}
+		}
+		weights[k] = 1 / denom
+	}
+	return weights
+}
+
+// remezExtrema находит count точек локальных максимумов |E| с чередующимися
+// знаками. Границы полос всегда считаются кандидатами в экстремумы
+func remezExtrema(errs, grid []float64, stopEdge float64, count int) ([]int, bool) {
+	// Сравниваем только соседей внутри одной полосы
+	sameBand := func(i, j int) bool {
+		return (grid[i] >= stopEdge) == (grid[j] >= stopEdge)
+	}
+
+	var candidates []int
+	for i := range errs {
+		if errs[i] == 0 {
+			continue
+		}
+		e := math.Abs(errs[i])
+		isEdge := i == 0 || i == len(errs)-1 || !sameBand(i, i-1) || !sameBand(i, i+1)
+		if !isEdge && (math.Abs(errs[i-1]) > e || math.Abs(errs[i+1]) > e) {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+
+	// Соседние кандидаты одного знака сливаются в наибольший по модулю
+	var alternating []int
+	for _, idx := range candidates {
+		last := len(alternating) - 1
+		if last >= 0 && math.Signbit(errs[alternating[last]]) == math.Signbit(errs[idx]) {
+			if math.Abs(errs[idx]) > math.Abs(errs[alternating[last]]) {
+				alternating[last] = idx
+			}
+			continue
+		}
+		alternating = append(alternating, idx)
+	}
+
+	// Лишние экстремумы удаляются с краев (меньший по модулю)
+	for len(alternating) > count {
+		if math.Abs(errs[alternating[0]]) < math.Abs(errs[alternating[len(alternating)-1]]) {
+			alternating = alternating[1:]
+		} else {
+			alternating = alternating[:len(alternating)-1]
+		}
+	}
+
+	return alternating, len(alternating) == count
+}
@@ -0,0 +1,107 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// bandDeviations возвращает максимальные отклонения АЧХ КИХ-фильтра в полосах
+func bandDeviations(coeffs []float64, passEdge, stopEdge float64) (passDev, stopDev float64) {
+	filter := NewFIRFilter(coeffs)
+	for i := 0; i <= 2000; i++ {
+		freq := 0.5 * float64(i) / 2000
+		mag := cmplx.Abs(filter.GetFrequencyResponse(freq))
+		switch {
+		case freq <= passEdge:
+			passDev = math.Max(passDev, math.Abs(mag-1))
+		case freq >= stopEdge:
+			stopDev = math.Max(stopDev, mag)
+		}
+	}
+	return passDev, stopDev
+}
+
+// TestRemezLowPass_Equiripple проверяет равноволновость и соотношение пульсаций
+func TestRemezLowPass_Equiripple(t *testing.T) {
+	tests := []struct {
+		name       string
+		passWeight float64
+		stopWeight float64
+	}{
+		{"Равные веса", 1, 1},
+		{"Вес полосы задерживания 10", 1, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const passEdge, stopEdge = 0.1, 0.15
+			coeffs, err := RemezLowPass(51, passEdge, stopEdge, tt.passWeight, tt.stopWeight)
+			if err != nil {
+				t.Fatalf("Ошибка расчета: %v", err)
+			}
+			if len(coeffs) != 51 {
+				t.Fatalf("Ожидалось 51 коэффициент, получено %d", len(coeffs))
+			}
+
+			// Линейная фаза: симметричные коэффициенты
+			for i := range coeffs {
+				if math.Abs(coeffs[i]-coeffs[len(coeffs)-1-i]) > 1e-12 {
+					t.Fatalf("Коэффициенты несимметричны в позиции %d", i)
+				}
+			}
+
+			passDev, stopDev := bandDeviations(coeffs, passEdge, stopEdge)
+
+			// Взвешенные отклонения в полосах равны
+			ratio := passDev * tt.passWeight / (stopDev * tt.stopWeight)
+			if math.Abs(ratio-1) > 0.05 {
+				t.Errorf("Взвешенные пульсации неравны: δp=%.5f, δs=%.5f (отношение %.3f)", passDev, stopDev, ratio)
+			}
+			if passDev > 0.05 || stopDev > 0.01 {
+				t.Errorf("Пульсации слишком велики: δp=%.5f, δs=%.5f", passDev, stopDev)
+			}
+		})
+	}
+}
+
+// TestRemezLowPass_BetterThanWindow проверяет, что равноволновый фильтр
+// при той же длине подавляет полосу задерживания не хуже оконного
+func TestRemezLowPass_BetterThanWindow(t *testing.T) {
+	const numTaps = 41
+	remez, err := RemezLowPass(numTaps, 0.1, 0.2, 1, 1)
+	if err != nil {
+		t.Fatalf("Ошибка расчета: %v", err)
+	}
+	window := DesignLowPassFIR(0.15, numTaps, nil)
+
+	_, remezStop := bandDeviations(remez, 0.1, 0.2)
+	_, windowStop := bandDeviations(window, 0.1, 0.2)
+	if remezStop > windowStop {
+		t.Errorf("Подавление Ремеза %.5f хуже оконного %.5f", remezStop, windowStop)
+	}
+}
+
+// TestRemezLowPass_InvalidParameters проверяет ошибки при некорректных параметрах
+func TestRemezLowPass_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name               string
+		numTaps            int
+		passEdge, stopEdge float64
+		passW, stopW       float64
+	}{
+		{"Четная длина", 50, 0.1, 0.2, 1, 1},
+		{"Слишком короткий", 1, 0.1, 0.2, 1, 1},
+		{"Перепутаны границы", 51, 0.2, 0.1, 1, 1},
+		{"Граница за Найквистом", 51, 0.1, 0.5, 1, 1},
+		{"Нулевой вес", 51, 0.1, 0.2, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := RemezLowPass(tt.numTaps, tt.passEdge, tt.stopEdge, tt.passW, tt.stopW); err == nil {
+				t.Error("Ожидалась ошибка")
+			}
+		})
+	}
+}
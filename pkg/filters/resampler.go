@@ -0,0 +1,106 @@
+package filters
+
+import "math"
+
+// resamplerTapsPerFactor определяет длину ФНЧ передискретизатора: 20*max(L, M)+1 коэффициентов
+const resamplerTapsPerFactor = 20
+
+// Resampler изменяет частоту дискретизации в рациональное число раз L/M.
+// Эквивалентен интерполяции в L раз, однократной фильтрации ФНЧ с частотой
+// среза min(0.5/L, 0.5/M) и децимации в M раз. Как и в Interpolator, фильтр
+// разложен на полифазные составляющие, а как и в Decimator, вычисляются
+// только сохраняемые отсчеты
+type Resampler struct {
+	up     int         // Коэффициент интерполяции L
+	down   int         // Коэффициент децимации M
+	phases [][]float64 // Полифазные составляющие ФНЧ
+	buffer []float64   // Кольцевой буфер входных отсчетов
+	pos    int         // Текущая позиция в буфере
+	phase  int         // Позиция следующего выходного отсчета внутри текущего входного (0..L-1)
+}
+
+// NewResampler создает передискретизатор с входной частотой inputRate
+// и выходной частотой outputRate. Частоты округляются до целых герц,
+// их отношение сокращается до несократимой дроби L/M
+func NewResampler(inputRate, outputRate float64) *Resampler {
+	in, out := int(math.Round(inputRate)), int(math.Round(outputRate))
+	if in <= 0 || out <= 0 {
+		panic("Resampler: sample rates must be positive")
+	}
+
+	g := gcd(in, out)
+	up, down := out/g, in/g
+
+	prototype := []float64{1}
+	if up > 1 || down > 1 {
+		factor := up
+		if down > factor {
+			factor = down
+		}
+		prototype = DesignLowPassFIR(0.5/float64(factor), resamplerTapsPerFactor*factor+1, nil)
+	}
+
+	phases := polyphase(prototype, up, float64(up))
+	return &Resampler{
+		up:     up,
+		down:   down,
+		phases: phases,
+		buffer: make([]float64, len(phases[0])),
+		pos:    len(phases[0]) - 1,
+	}
+}
+
+// Process обрабатывает срез входных отсчетов. Состояние сохраняется между
+// вызовами; для потока из N входных отсчетов суммарно выдается ceil(N*L/M)
+// выходных отсчетов
+func (r *Resampler) Process(input []float64) []float64 {
+	output := make([]float64, 0, len(input)*r.up/r.down+1)
+	n := len(r.buffer)
+
+	for _, val := range input {
+		r.pos++
+		if r.pos == n {
+			r.pos = 0
+		}
+		r.buffer[r.pos] = val
+
+		// Выходные отсчеты, попадающие на интервал текущего входного отсчета
+		for ; r.phase < r.up; r.phase += r.down {
+			var acc float64
+			bufIdx := r.pos
+			for _, c := range r.phases[r.phase] {
+				acc += c * r.buffer[bufIdx]
+				bufIdx--
+				if bufIdx < 0 {
+					bufIdx = n - 1
+				}
+			}
+			output = append(output, acc)
+		}
+		r.phase -= r.up
+	}
+
+	return output
+}
+
+// Reset сбрасывает состояние передискретизатора
+func (r *Resampler) Reset() {
+	for i := range r.buffer {
+		r.buffer[i] = 0
+	}
+	r.pos = len(r.buffer) - 1
+	r.phase = 0
+}
+
+// GetRatio возвращает коэффициенты интерполяции L и децимации M
+func (r *Resampler) GetRatio() (up, down int) {
+	return r.up, r.down
+}
+
+// gcd возвращает наибольший общий делитель двух положительных чисел
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,70 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestResampler_44100To48000 проверяет передискретизацию тона 1 кГц
+func TestResampler_44100To48000(t *testing.T) {
+	const (
+		inRate  = 44100.0
+		outRate = 48000.0
+	)
+
+	r := NewResampler(inRate, outRate)
+	if up, down := r.GetRatio(); up != 160 || down != 147 {
+		t.Fatalf("Отношение: ожидалось 160/147, получено %d/%d", up, down)
+	}
+
+	input := make([]float64, 44100)
+	for i := range input {
+		input[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / inRate)
+	}
+
+	// Обработка блоками разной длины
+	output := append(r.Process(input[:1000]), r.Process(input[1000:])...)
+	if len(output) != 48000 {
+		t.Fatalf("Ожидалось 48000 отсчетов, получено %d", len(output))
+	}
+
+	// Выход совпадает с синусоидой 1 кГц, задержанной на групповую задержку ФНЧ
+	delay := float64(resamplerTapsPerFactor*160/2) / (160 * inRate)
+	var maxErr float64
+	for i := 1000; i < len(output)-1000; i++ {
+		expected := math.Sin(2 * math.Pi * 1000 * (float64(i)/outRate - delay))
+		maxErr = math.Max(maxErr, math.Abs(output[i]-expected))
+	}
+	if maxErr > 0.01 {
+		t.Errorf("Максимальное отклонение от синусоиды 1 кГц: %f", maxErr)
+	}
+}
+
+// TestResampler_LengthAccounting проверяет количество выходных отсчетов
+func TestResampler_LengthAccounting(t *testing.T) {
+	tests := []struct {
+		in, out float64
+		n       int
+	}{
+		{44100, 48000, 1000},
+		{48000, 44100, 1000},
+		{8000, 16000, 333},
+		{16000, 8000, 333},
+		{8000, 8000, 10},
+	}
+
+	for _, tt := range tests {
+		r := NewResampler(tt.in, tt.out)
+		up, down := r.GetRatio()
+
+		total := 0
+		for i := 0; i < tt.n; i++ {
+			total += len(r.Process([]float64{1}))
+		}
+
+		expected := (tt.n*up + down - 1) / down
+		if total != expected {
+			t.Errorf("%.0f -> %.0f: ожидалось %d отсчетов, получено %d", tt.in, tt.out, expected, total)
+		}
+	}
+}
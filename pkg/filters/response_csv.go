@@ -0,0 +1,50 @@
+package filters
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+// WriteFrequencyResponseCSV записывает таблицу частотной характеристики фильтра
+// в формате CSV со столбцами freq,mag_db,phase_deg. Нормированная частота
+// изменяется от 0 до 0.5 включительно за numPoints (>= 2) равных шагов.
+// Значения берутся из GetFrequencyResponse (запаздывание по фазе отрицательно);
+// нулевой модуль записывается как -Inf
+func WriteFrequencyResponseCSV(w io.Writer, f *IIRFilter, numPoints int) error {
+	if f == nil {
+		return errors.New("WriteFrequencyResponseCSV: filter cannot be nil")
+	}
+	if numPoints < 2 {
+		return fmt.Errorf("WriteFrequencyResponseCSV: numPoints must be at least 2, got %d", numPoints)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"freq", "mag_db", "phase_deg"}); err != nil {
+		return err
+	}
+
+	for i := 0; i < numPoints; i++ {
+		freq := 0.5 * float64(i) / float64(numPoints-1)
+		response := f.GetFrequencyResponse(freq)
+
+		magDB := 20 * math.Log10(cmplx.Abs(response))
+		phaseDeg := cmplx.Phase(response) * 180 / math.Pi
+
+		record := []string{
+			strconv.FormatFloat(freq, 'g', -1, 64),
+			strconv.FormatFloat(magDB, 'g', -1, 64),
+			strconv.FormatFloat(phaseDeg, 'g', -1, 64),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,102 @@
+package filters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math"
+	"math/cmplx"
+	"strconv"
+	"testing"
+)
+
+// TestWriteFrequencyResponseCSV проверяет заголовок и значения на краях диапазона
+func TestWriteFrequencyResponseCSV(t *testing.T) {
+	filter := NewSecondOrderLowPass(0.1, 0.9)
+
+	var buf bytes.Buffer
+	if err := WriteFrequencyResponseCSV(&buf, filter, 11); err != nil {
+		t.Fatalf("Ошибка записи: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Ошибка разбора CSV: %v", err)
+	}
+	if len(records) != 12 {
+		t.Fatalf("Ожидалось 12 строк (заголовок + 11), получено %d", len(records))
+	}
+	if h := records[0]; h[0] != "freq" || h[1] != "mag_db" || h[2] != "phase_deg" {
+		t.Errorf("Неверный заголовок: %v", h)
+	}
+
+	for _, row := range []int{1, 3, 6, 11} {
+		values := make([]float64, 3)
+		for i, field := range records[row] {
+			v, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				t.Fatalf("Строка %d: ошибка разбора %q: %v", row, field, err)
+			}
+			values[i] = v
+		}
+
+		// Характеристика вычисляется независимо: H = B(e^-jω) / A(e^-jω)
+		freq := 0.05 * float64(row-1)
+		response := evalTransfer(filter.GetBCoeffs(), freq) / evalTransfer(filter.GetACoeffs(), freq)
+		expected := []float64{
+			freq,
+			20 * math.Log10(cmplx.Abs(response)),
+			cmplx.Phase(response) * 180 / math.Pi,
+		}
+		for i := range expected {
+			if math.Abs(values[i]-expected[i]) > 1e-9 {
+				t.Errorf("Строка %d, столбец %s: ожидалось %v, получено %v", row, records[0][i], expected[i], values[i])
+			}
+		}
+	}
+}
+
+// TestWriteFrequencyResponseCSV_PhaseSign проверяет, что ФНЧ записывается
+// с запаздыванием по фазе: на частоте среза биквадратного ФНЧ фаза равна -90°
+func TestWriteFrequencyResponseCSV_PhaseSign(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFrequencyResponseCSV(&buf, NewSecondOrderLowPass(0.1, 0.9), 11); err != nil {
+		t.Fatalf("Ошибка записи: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Ошибка разбора CSV: %v", err)
+	}
+
+	for row := 2; row < len(records)-1; row++ {
+		phase, err := strconv.ParseFloat(records[row][2], 64)
+		if err != nil {
+			t.Fatalf("Строка %d: ошибка разбора %q: %v", row, records[row][2], err)
+		}
+		if phase >= 0 {
+			t.Errorf("Строка %d: ожидалась отрицательная фаза, получено %f°", row, phase)
+		}
+		if row == 3 && math.Abs(phase+90) > 1e-6 {
+			t.Errorf("Частота среза: ожидалось -90°, получено %f°", phase)
+		}
+	}
+}
+
+// evalTransfer вычисляет sum(c[k] * e^(-jωk)) на нормированной частоте freq
+func evalTransfer(coeffs []float64, freq float64) complex128 {
+	var sum complex128
+	for k, c := range coeffs {
+		sum += complex(c, 0) * cmplx.Exp(complex(0, -2*math.Pi*freq*float64(k)))
+	}
+	return sum
+}
+
+// TestWriteFrequencyResponseCSV_Errors проверяет ошибки при некорректных параметрах
+func TestWriteFrequencyResponseCSV_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFrequencyResponseCSV(&buf, NewFirstOrderLowPass(0.1), 1); err == nil {
+		t.Error("Ожидалась ошибка при numPoints = 1")
+	}
+	if err := WriteFrequencyResponseCSV(&buf, nil, 10); err == nil {
+		t.Error("Ожидалась ошибка при nil фильтре")
+	}
+}
@@ -0,0 +1,137 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// Poles возвращает полюса фильтра (корни полинома знаменателя в плоскости z).
+// Полюса в начале координат, возникающие при len(bCoeffs) > len(aCoeffs),
+// также включаются в результат
+func (f *IIRFilter) Poles() []complex128 {
+	return polyRoots(padCoeffs(f.aCoeffs, f.order+1))
+}
+
+// Zeros возвращает нули фильтра (корни полинома числителя в плоскости z).
+// Нули в начале координат, возникающие при len(aCoeffs) > len(bCoeffs),
+// также включаются в результат; нули в бесконечности (b0 = 0) отбрасываются
+func (f *IIRFilter) Zeros() []complex128 {
+	return polyRoots(padCoeffs(f.bCoeffs, f.order+1))
+}
+
+// StabilityMargin возвращает запас устойчивости 1 - max|p| по наиболее
+// удаленному от начала координат полюсу: положительный, если все полюса
+// внутри единичной окружности, отрицательный, если хотя бы один снаружи.
+// В отличие от IsStable позволяет отбраковать фильтры с полюсами вблизи
+// границы, например margin < 1e-3. Фильтр без полюсов имеет запас 1.
+// Точность определяется поиском корней: для кратных полюсов она порядка 1e-8
+func (f *IIRFilter) StabilityMargin() float64 {
+	var radius float64
+	for _, p := range f.Poles() {
+		radius = math.Max(radius, cmplx.Abs(p))
+	}
+	return 1 - radius
+}
+
+// padCoeffs дополняет коэффициенты нулями до длины n
+func padCoeffs(coeffs []float64, n int) []float64 {
+	padded := make([]float64, n)
+	copy(padded, coeffs)
+	return padded
+}
+
+// polyRoots находит корни полинома c[0]*z^N + c[1]*z^(N-1) + ... + c[N]
+// методом Дюранда-Кернера. Корни сортируются по вещественной, затем по мнимой части
+func polyRoots(coeffs []float64) []complex128 {
+	// Нулевые старшие коэффициенты соответствуют корням в бесконечности
+	start := 0
+	for start < len(coeffs) && coeffs[start] == 0 {
+		start++
+	}
+	coeffs = coeffs[start:]
+	if len(coeffs) <= 1 {
+		return []complex128{}
+	}
+
+	// Нулевые младшие коэффициенты соответствуют корням в нуле
+	var roots []complex128
+	end := len(coeffs)
+	for end > 1 && coeffs[end-1] == 0 {
+		roots = append(roots, 0)
+		end--
+	}
+	coeffs = coeffs[:end]
+
+	degree := len(coeffs) - 1
+	if degree > 0 {
+		// Приводим полином к унитарному виду
+		monic := make([]complex128, len(coeffs))
+		for i, c := range coeffs {
+			monic[i] = complex(c/coeffs[0], 0)
+		}
+		roots = append(roots, durandKerner(monic)...)
+	}
+
+	// Убираем мнимые части, возникающие из-за погрешности округления
+	for i, r := range roots {
+		if math.Abs(imag(r)) < 1e-10*math.Max(1, cmplx.Abs(r)) {
+			roots[i] = complex(real(r), 0)
+		}
+	}
+
+	sort.Slice(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+	return roots
+}
+
+// durandKerner находит все корни унитарного полинома одновременными итерациями
+func durandKerner(monic []complex128) []complex128 {
+	degree := len(monic) - 1
+
+	// Начальные приближения: степени числа, не являющегося корнем из единицы
+	roots := make([]complex128, degree)
+	seed := complex(0.4, 0.9)
+	roots[0] = 1
+	for i := 1; i < degree; i++ {
+		roots[i] = roots[i-1] * seed
+	}
+
+	eval := func(z complex128) complex128 {
+		result := monic[0]
+		for _, c := range monic[1:] {
+			result = result*z + c
+		}
+		return result
+	}
+
+	const maxIterations = 1000
+	for iter := 0; iter < maxIterations; iter++ {
+		var maxDelta float64
+		for i := range roots {
+			denom := complex(1, 0)
+			for j := range roots {
+				if i != j {
+					denom *= roots[i] - roots[j]
+				}
+			}
+			if denom == 0 {
+				denom = complex(1e-12, 0)
+			}
+
+			delta := eval(roots[i]) / denom
+			roots[i] -= delta
+			maxDelta = math.Max(maxDelta, cmplx.Abs(delta))
+		}
+
+		if maxDelta < 1e-15 {
+			break
+		}
+	}
+
+	return roots
+}
@@ -0,0 +1,114 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// matchRoots проверяет, что каждому ожидаемому корню соответствует найденный
+func matchRoots(t *testing.T, name string, got, want []complex128, tolerance float64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("%s: ожидалось %d корней, получено %d (%v)", name, len(want), len(got), got)
+	}
+
+	used := make([]bool, len(got))
+	for _, w := range want {
+		best, bestDist := -1, math.Inf(1)
+		for i, g := range got {
+			if d := cmplx.Abs(g - w); !used[i] && d < bestDist {
+				best, bestDist = i, d
+			}
+		}
+		if bestDist > tolerance {
+			t.Errorf("%s: корень %v не найден (ближайший %v)", name, w, got[best])
+			continue
+		}
+		used[best] = true
+	}
+}
+
+// TestIIRFilter_PolesZerosSecondOrderLowPass проверяет полюса и нули биквадратного ФНЧ
+func TestIIRFilter_PolesZerosSecondOrderLowPass(t *testing.T) {
+	fc, Q := 0.1, 2.0
+	filter := NewSecondOrderLowPass(fc, Q)
+
+	// Аналитические полюса: корни z^2 + a1*z + a2 = 0
+	w0 := 2 * math.Pi * fc
+	alpha := math.Sin(w0) / (2 * Q)
+	radius := math.Sqrt((1 - alpha) / (1 + alpha))
+	theta := math.Acos(math.Cos(w0) / (1 + alpha) / radius)
+	wantPoles := []complex128{cmplx.Rect(radius, theta), cmplx.Rect(radius, -theta)}
+
+	matchRoots(t, "полюса", filter.Poles(), wantPoles, 1e-12)
+
+	// ФНЧ имеет двойной нуль в z = -1 (кратный корень сходится медленнее)
+	matchRoots(t, "нули", filter.Zeros(), []complex128{-1, -1}, 1e-6)
+
+	// Устойчивость согласуется с расположением полюсов
+	for _, p := range filter.Poles() {
+		if cmplx.Abs(p) >= 1 {
+			t.Errorf("Полюс %v вне единичной окружности у устойчивого фильтра", p)
+		}
+	}
+}
+
+// TestIIRFilter_PolesZerosHighOrder проверяет поиск корней полиномов высокого порядка
+func TestIIRFilter_PolesZerosHighOrder(t *testing.T) {
+	wantPoles := []complex128{0.9, -0.8, 0.5, 0.1, -0.3}
+	aCoeffs := polyFromRoots(0.9, -0.8, 0.5, 0.1, -0.3)
+	bCoeffs := []float64{1, -0.5} // Нуль в 0.5 и четыре нуля в начале координат
+
+	filter := NewIIRFilter(bCoeffs, aCoeffs)
+
+	matchRoots(t, "полюса", filter.Poles(), wantPoles, 1e-9)
+	matchRoots(t, "нули", filter.Zeros(), []complex128{0.5, 0, 0, 0, 0}, 1e-9)
+}
+
+// TestIIRFilter_PolesFirstOrder проверяет полюс и нуль фильтра 1-го порядка
+func TestIIRFilter_PolesFirstOrder(t *testing.T) {
+	// H(z) = 0.5 / (1 + 0.3 z^-1) = 0.5 z / (z + 0.3)
+	filter := NewIIRFilter([]float64{0.5}, []float64{1, 0.3})
+
+	matchRoots(t, "полюса", filter.Poles(), []complex128{-0.3}, 1e-12)
+	matchRoots(t, "нули", filter.Zeros(), []complex128{0}, 1e-12)
+}
+
+// TestIIRFilter_StabilityMargin проверяет знак и величину запаса устойчивости
+func TestIIRFilter_StabilityMargin(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *IIRFilter
+		want   float64
+	}{
+		{"Полюс 0.5", NewIIRFilter([]float64{1}, []float64{1, -0.5}), 0.5},
+		{"Полюс вблизи границы", NewIIRFilter([]float64{1}, []float64{1, -0.9999999}), 1e-7},
+		{"Полюс на окружности", NewIIRFilter([]float64{1}, []float64{1, -1}), 0},
+		{"Полюс снаружи", NewIIRFilter([]float64{1}, []float64{1, 1.5}), -0.5},
+		{"Комплексные полюса 1.1", NewIIRFilter([]float64{1}, []float64{1, 0, 1.21}), -0.1},
+		{"Без полюсов", NewIIRFilter([]float64{0.5, 0.5}, []float64{1}), 1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.StabilityMargin(); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: ожидалось %g, получено %g", tt.name, tt.want, got)
+		}
+	}
+
+	// Спроектированные фильтры имеют положительный запас, согласованный с IsStable
+	for _, filter := range []*IIRFilter{
+		NewSecondOrderLowPass(0.1, 0.707),
+		NewResonator(0.2, 50),
+		NewLeakyIntegrator(0.99),
+	} {
+		margin := filter.StabilityMargin()
+		if margin <= 0 || margin >= 1 {
+			t.Errorf("Устойчивый фильтр: ожидался запас в (0, 1), получено %g", margin)
+		}
+		if filter.IsStable() != (margin > 0) {
+			t.Errorf("Запас %g не согласован с IsStable", margin)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// sanitizeInput возвращает тестовый сигнал с NaN в позиции bad
+// и тот же сигнал с нулем вместо NaN
+func sanitizeInput(n, bad int) (withNaN, withZero []float64) {
+	withNaN = make([]float64, n)
+	withZero = make([]float64, n)
+	for i := range withNaN {
+		withNaN[i] = math.Sin(0.1 * float64(i))
+		withZero[i] = withNaN[i]
+	}
+	withNaN[bad] = math.NaN()
+	withZero[bad] = 0
+	return withNaN, withZero
+}
+
+// TestIIRFilter_SanitizeRecovers проверяет, что в режиме очистки NaN
+// не блокирует фильтр, а без него выход остается NaN
+func TestIIRFilter_SanitizeRecovers(t *testing.T) {
+	withNaN, withZero := sanitizeInput(400, 100)
+
+	filter := NewSecondOrderLowPass(0.1, 0.707)
+	filter.SetSanitize(true)
+	reference := NewSecondOrderLowPass(0.1, 0.707)
+
+	got := filter.Process(withNaN)
+	want := reference.Process(withZero)
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, want[i], got[i])
+		}
+	}
+
+	// Без очистки NaN сохраняется в обратной связи
+	strict := NewSecondOrderLowPass(0.1, 0.707)
+	output := strict.Process(withNaN)
+	if !math.IsNaN(output[len(output)-1]) {
+		t.Error("Без очистки ожидался NaN на выходе")
+	}
+
+	// TDF-II и ProcessInPlace также очищают вход
+	tdf2 := NewSecondOrderLowPass(0.1, 0.707)
+	tdf2.SetSanitize(true)
+	if out := tdf2.ProcessTDF2(withNaN); math.IsNaN(out[len(out)-1]) {
+		t.Error("TDF-II: выход остался NaN")
+	}
+	inPlace := NewSecondOrderLowPass(0.1, 0.707)
+	inPlace.SetSanitize(true)
+	buf := []float64{1, math.Inf(1), 1, 1}
+	inPlace.ProcessInPlace(buf)
+	for i, v := range buf {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Errorf("ProcessInPlace: отсчет %d не конечен: %f", i, v)
+		}
+	}
+}
+
+// TestFIRFilter_SanitizeRecovers проверяет восстановление КИХ-фильтра
+func TestFIRFilter_SanitizeRecovers(t *testing.T) {
+	withNaN, withZero := sanitizeInput(200, 50)
+	coeffs := []float64{0.25, 0.5, 0.25}
+
+	filter := NewFIRFilter(coeffs)
+	filter.SetSanitize(true)
+	reference := NewFIRFilter(coeffs)
+
+	// Поотсчетная и блочная обработка
+	for i, val := range withNaN[:100] {
+		if got, want := filter.Tick(val), reference.Tick(withZero[i]); got != want {
+			t.Fatalf("Tick, отсчет %d: ожидалось %f, получено %f", i, want, got)
+		}
+	}
+	got := filter.Process(withNaN[100:])
+	want := reference.Process(withZero[100:])
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Process, отсчет %d: ожидалось %f, получено %f", 100+i, want[i], got[i])
+		}
+	}
+
+	// Без очистки NaN влияет только на длину импульсной характеристики
+	strict := NewFIRFilter(coeffs)
+	output := strict.Process(withNaN)
+	if !math.IsNaN(output[50]) || math.IsNaN(output[50+len(coeffs)]) {
+		t.Error("Без очистки NaN должен занимать ровно len(coeffs) выходных отсчетов")
+	}
+}
@@ -0,0 +1,124 @@
+package filters
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// SlidingGoertzel представляет собой скользящий фильтр Герцеля (скользящее ДПФ)
+// для непрерывного контроля амплитуды одной частоты в потоке отсчетов.
+//
+// На каждом отсчете из состояния вычитается вклад самого старого отсчета окна:
+//
+//	X[n] = (X[n-1] + x[n] - x[n-N]) * e^(j*w)
+//
+// Замечание об устойчивости: полюс резонатора лежит точно на единичной окружности,
+// поэтому ошибки округления не затухают, а накапливаются. На очень длинных потоках
+// рекомендуется периодически вызывать Reset (или сравнивать с блочным фильтром Герцеля).
+type SlidingGoertzel struct {
+	k       int        // Частота отсчёта, соответствующая искомой частоте
+	twiddle complex128 // Поворачивающий множитель e^(j*w)
+	state   complex128 // Текущее значение бина ДПФ
+	buffer  []float64  // Кольцевой буфер последних N отсчетов
+	pos     int        // Позиция самого старого отсчета в буфере
+	count   int        // Количество обработанных отсчетов (до N)
+}
+
+// NewSlidingGoertzel создает новый скользящий фильтр Герцеля с окном из windowN отсчетов
+func NewSlidingGoertzel(freq float64, samplingRate float64, windowN int) (*SlidingGoertzel, error) {
+	// Проверка граничных условий
+	if freq <= 0 {
+		return nil, &InvalidParameterError{Param: "freq", Value: freq, Reason: "frequency must be positive"}
+	}
+	if samplingRate <= 0 {
+		return nil, &InvalidParameterError{Param: "samplingRate", Value: samplingRate, Reason: "sampling rate must be positive"}
+	}
+	if windowN <= 0 {
+		return nil, &InvalidParameterError{Param: "windowN", Value: float64(windowN), Reason: "window size must be positive"}
+	}
+	if freq >= samplingRate/2 {
+		return nil, &InvalidParameterError{
+			Param:  "freq",
+			Value:  freq,
+			Reason: "frequency must be less than Nyquist frequency (samplingRate/2)",
+		}
+	}
+
+	// Расчет параметров (как в NewGoertzelFilter)
+	k := int(0.5 + float64(windowN)*freq/samplingRate)
+	if k >= windowN {
+		k = windowN - 1
+	}
+
+	w := 2 * math.Pi * float64(k) / float64(windowN)
+
+	return &SlidingGoertzel{
+		k:       k,
+		twiddle: complex(math.Cos(w), math.Sin(w)),
+		buffer:  make([]float64, windowN),
+	}, nil
+}
+
+// Process обрабатывает один отсчет сигнала и сдвигает окно анализа
+func (sg *SlidingGoertzel) Process(sample float64) error {
+	if sg == nil {
+		return &InvalidStateError{Reason: "filter is not initialized"}
+	}
+
+	// Вычитаем вклад самого старого отсчета и добавляем новый
+	oldest := sg.buffer[sg.pos]
+	sg.buffer[sg.pos] = sample
+	sg.pos = (sg.pos + 1) % len(sg.buffer)
+
+	sg.state = (sg.state + complex(sample-oldest, 0)) * sg.twiddle
+
+	if sg.count < len(sg.buffer) {
+		sg.count++
+	}
+	return nil
+}
+
+// Magnitude возвращает амплитуду искомой частоты по последним N отсчетам
+// (нормировка такая же, как в GoertzelFilter.GetMagnitude)
+func (sg *SlidingGoertzel) Magnitude() (float64, error) {
+	if sg == nil {
+		return 0, &InvalidStateError{Reason: "filter is not initialized"}
+	}
+
+	if !sg.IsReady() {
+		return 0, &InvalidStateError{Reason: "window is not filled yet"}
+	}
+
+	return 2 * cmplx.Abs(sg.state) / float64(len(sg.buffer)), nil
+}
+
+// IsReady возвращает true, если окно анализа полностью заполнено
+func (sg *SlidingGoertzel) IsReady() bool {
+	if sg == nil {
+		return false
+	}
+	return sg.count >= len(sg.buffer)
+}
+
+// Reset сбрасывает состояние фильтра и очищает окно
+func (sg *SlidingGoertzel) Reset() error {
+	if sg == nil {
+		return &InvalidStateError{Reason: "filter is not initialized"}
+	}
+
+	for i := range sg.buffer {
+		sg.buffer[i] = 0
+	}
+	sg.state = 0
+	sg.pos = 0
+	sg.count = 0
+	return nil
+}
+
+// GetTargetFrequency возвращает целевую частоту
+func (sg *SlidingGoertzel) GetTargetFrequency(samplingRate float64) float64 {
+	if sg == nil || len(sg.buffer) == 0 {
+		return 0
+	}
+	return float64(sg.k) * samplingRate / float64(len(sg.buffer))
+}
@@ -0,0 +1,140 @@
+package filters
+
+import (
+	"math"
+	"testing"
+)
+
+// TestSlidingGoertzel_SteadyTone проверяет амплитуду устойчивого тона на потоке
+func TestSlidingGoertzel_SteadyTone(t *testing.T) {
+	samplingRate := 8000.0
+	freq := 1000.0
+	windowN := 64
+	amplitude := 0.8
+
+	sg, err := NewSlidingGoertzel(freq, samplingRate, windowN)
+	if err != nil {
+		t.Fatalf("failed to create filter: %v", err)
+	}
+
+	if _, err := sg.Magnitude(); err == nil {
+		t.Error("expected error before the window is filled")
+	}
+
+	for i := 0; i < 1000; i++ {
+		sample := amplitude * math.Sin(2*math.Pi*freq*float64(i)/samplingRate+0.3)
+		if err := sg.Process(sample); err != nil {
+			t.Fatalf("failed to process sample: %v", err)
+		}
+
+		if i < windowN-1 {
+			if sg.IsReady() {
+				t.Fatalf("filter should not be ready after %d samples", i+1)
+			}
+			continue
+		}
+
+		magnitude, err := sg.Magnitude()
+		if err != nil {
+			t.Fatalf("failed to get magnitude: %v", err)
+		}
+		if math.Abs(magnitude-amplitude) > 1e-9 {
+			t.Fatalf("sample %d: magnitude = %v, want %v", i, magnitude, amplitude)
+		}
+	}
+}
+
+// TestSlidingGoertzel_MatchesBlock проверяет совпадение с блочным фильтром Герцеля
+func TestSlidingGoertzel_MatchesBlock(t *testing.T) {
+	samplingRate := 8000.0
+	freq := 1250.0
+	windowN := 32
+
+	signal := make([]float64, 200)
+	for i := range signal {
+		signal[i] = math.Sin(2*math.Pi*freq*float64(i)/samplingRate) +
+			0.4*math.Cos(2*math.Pi*2750*float64(i)/samplingRate) +
+			0.1*float64(i%7)
+	}
+
+	sg, err := NewSlidingGoertzel(freq, samplingRate, windowN)
+	if err != nil {
+		t.Fatalf("failed to create sliding filter: %v", err)
+	}
+	block, err := NewGoertzelFilter(freq, samplingRate, windowN)
+	if err != nil {
+		t.Fatalf("failed to create block filter: %v", err)
+	}
+
+	for i, sample := range signal {
+		if err := sg.Process(sample); err != nil {
+			t.Fatalf("failed to process sample: %v", err)
+		}
+		if i < windowN-1 {
+			continue
+		}
+
+		// Блочный фильтр по тому же окну
+		_ = block.Reset()
+		for _, s := range signal[i-windowN+1 : i+1] {
+			_ = block.Process(s)
+		}
+		want, _ := block.GetMagnitude()
+		got, _ := sg.Magnitude()
+
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("sample %d: sliding magnitude = %v, block magnitude = %v", i, got, want)
+		}
+	}
+
+	if sg.GetTargetFrequency(samplingRate) != block.GetTargetFrequency(samplingRate) {
+		t.Errorf("target frequency mismatch: %v vs %v",
+			sg.GetTargetFrequency(samplingRate), block.GetTargetFrequency(samplingRate))
+	}
+}
+
+// TestSlidingGoertzel_Reset проверяет сброс состояния
+func TestSlidingGoertzel_Reset(t *testing.T) {
+	sg, err := NewSlidingGoertzel(1000, 8000, 16)
+	if err != nil {
+		t.Fatalf("failed to create filter: %v", err)
+	}
+
+	for i := 0; i < 40; i++ {
+		_ = sg.Process(1.0)
+	}
+	if err := sg.Reset(); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+	if sg.IsReady() {
+		t.Error("filter should not be ready after reset")
+	}
+}
+
+// TestSlidingGoertzel_InvalidParams проверяет обработку неверных параметров
+func TestSlidingGoertzel_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		freq         float64
+		samplingRate float64
+		windowN      int
+	}{
+		{"zero frequency", 0, 8000, 64},
+		{"negative sampling rate", 1000, -8000, 64},
+		{"zero window", 1000, 8000, 0},
+		{"above Nyquist", 5000, 8000, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSlidingGoertzel(tt.freq, tt.samplingRate, tt.windowN); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	var sg *SlidingGoertzel
+	if err := sg.Process(1.0); err == nil {
+		t.Error("expected error for nil filter")
+	}
+}
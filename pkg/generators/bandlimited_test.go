@@ -0,0 +1,91 @@
+package generators
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"dsp_go/pkg/fft"
+)
+
+// aliasedEnergy возвращает долю энергии спектра вне гармоник f0 ниже частоты Найквиста.
+// Длительность сигнала 1 с, поэтому бин k соответствует частоте k Гц
+func aliasedEnergy(signal []float64, f0 int, oddOnly bool) float64 {
+	spectrum := fft.RFFT(signal)
+
+	var total, aliased float64
+	for k := 1; k < len(spectrum); k++ {
+		energy := cmplx.Abs(spectrum[k]) * cmplx.Abs(spectrum[k])
+		total += energy
+
+		harmonic := k%f0 == 0 && (!oddOnly || (k/f0)%2 == 1)
+		if !harmonic {
+			aliased += energy
+		}
+	}
+	return aliased / total
+}
+
+// TestBandLimited_NoAliasing проверяет отсутствие наложения спектров
+// у сигналов с частотой около 1/8 частоты Найквиста
+func TestBandLimited_NoAliasing(t *testing.T) {
+	const (
+		fs = 8192
+		f0 = 530 // Гармоники выше Найквиста отражаются в бины между гармониками
+	)
+
+	tests := []struct {
+		signalType SignalType
+		oddOnly    bool
+	}{
+		{Square, true},
+		{Sawtooth, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.signalType.String(), func(t *testing.T) {
+			gen := NewReferenceSignalGenerator()
+			gen.SampleRate = fs
+			gen.Frequency = f0
+			gen.SignalType = tt.signalType
+
+			naive, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			gen.BandLimited = true
+			bandLimited, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if ratio := aliasedEnergy(naive, f0, tt.oddOnly); ratio < 1e-3 {
+				t.Errorf("Наивный сигнал: ожидалось заметное наложение, получено %.2e", ratio)
+			}
+			if ratio := aliasedEnergy(bandLimited, f0, tt.oddOnly); ratio > 1e-12 {
+				t.Errorf("Сигнал с ограниченной полосой: доля наложенной энергии %.2e", ratio)
+			}
+		})
+	}
+}
+
+// TestBandLimited_Shape проверяет, что форма сигнала близка к наивной
+func TestBandLimited_Shape(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.SampleRate = 48000
+	gen.Frequency = 100
+	gen.TotalTime = 0.01
+	gen.SignalType = Square
+	gen.BandLimited = true
+
+	signal, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	// Середина полупериодов вдали от фронтов (без эффекта Гиббса)
+	if math.Abs(signal[120]-1) > 0.01 || math.Abs(signal[360]+1) > 0.01 {
+		t.Errorf("Ожидались уровни ±1 в середине полупериодов, получено %f и %f", signal[120], signal[360])
+	}
+}
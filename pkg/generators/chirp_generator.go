@@ -0,0 +1,127 @@
+package generators
+
+import (
+	"fmt"
+	"math"
+)
+
+// SweepType определяет закон изменения частоты ЛЧМ-сигнала
+type SweepType int
+
+const (
+	LinearSweep      SweepType = iota // Линейное изменение частоты
+	LogarithmicSweep                  // Экспоненциальное изменение частоты (постоянная скорость в октавах)
+)
+
+// String возвращает строковое представление закона изменения частоты
+func (st SweepType) String() string {
+	switch st {
+	case LinearSweep:
+		return "Линейный"
+	case LogarithmicSweep:
+		return "Логарифмический"
+	default:
+		return "Неизвестный"
+	}
+}
+
+// ChirpGenerator генерирует синусоиду с качающейся частотой (chirp),
+// мгновенная частота которой изменяется от StartFrequency до EndFrequency
+// за время TotalTime. Фаза вычисляется как интеграл мгновенной частоты,
+// поэтому сигнал не имеет разрывов фазы
+type ChirpGenerator struct {
+	StartFrequency float64   // Начальная частота в герцах
+	EndFrequency   float64   // Конечная частота в герцах
+	SampleRate     float64   // Частота дискретизации в герцах
+	TotalTime      float64   // Длительность сигнала в секундах
+	Amplitude      float64   // Амплитуда сигнала
+	Phase          float64   // Начальная фаза в радианах
+	SweepType      SweepType // Закон изменения частоты
+}
+
+// NewChirpGenerator создает новый генератор с настройками по умолчанию
+func NewChirpGenerator() *ChirpGenerator {
+	return &ChirpGenerator{
+		StartFrequency: 100.0,
+		EndFrequency:   3000.0,
+		SampleRate:     8000.0,
+		TotalTime:      1.0,
+		Amplitude:      1.0,
+		Phase:          0.0,
+		SweepType:      LinearSweep,
+	}
+}
+
+// Generate создает массив отсчётов ЛЧМ-сигнала
+func (cg *ChirpGenerator) Generate() ([]float64, error) {
+	if err := cg.validate(); err != nil {
+		return nil, err
+	}
+
+	numSamples := int(math.Round(cg.TotalTime * cg.SampleRate))
+	signals := make([]float64, numSamples)
+
+	timeStep := 1.0 / cg.SampleRate
+	for i := range signals {
+		signals[i] = cg.Amplitude * math.Sin(cg.phaseAt(float64(i)*timeStep))
+	}
+
+	return signals, nil
+}
+
+// InstantaneousFrequency возвращает мгновенную частоту в герцах в момент времени t
+func (cg *ChirpGenerator) InstantaneousFrequency(t float64) float64 {
+	f0, f1, T := cg.StartFrequency, cg.EndFrequency, cg.TotalTime
+
+	if cg.SweepType == LogarithmicSweep {
+		return f0 * math.Pow(f1/f0, t/T)
+	}
+	return f0 + (f1-f0)*t/T
+}
+
+// phaseAt возвращает фазу сигнала в момент времени t (интеграл мгновенной частоты)
+func (cg *ChirpGenerator) phaseAt(t float64) float64 {
+	f0, f1, T := cg.StartFrequency, cg.EndFrequency, cg.TotalTime
+
+	var cycles float64
+	if cg.SweepType == LogarithmicSweep && f0 != f1 {
+		// f(t) = f0 * k^t, k = (f1/f0)^(1/T)
+		logK := math.Log(f1/f0) / T
+		cycles = f0 * (math.Exp(logK*t) - 1) / logK
+	} else {
+		// f(t) = f0 + (f1-f0)*t/T
+		cycles = f0*t + (f1-f0)*t*t/(2*T)
+	}
+
+	return 2*math.Pi*cycles + cg.Phase
+}
+
+// validate проверяет корректность параметров
+func (cg *ChirpGenerator) validate() error {
+	if cg.StartFrequency <= 0 || cg.EndFrequency <= 0 {
+		return fmt.Errorf("частоты должны быть положительными: %f, %f", cg.StartFrequency, cg.EndFrequency)
+	}
+	if cg.SampleRate <= 0 {
+		return fmt.Errorf("частота дискретизации должна быть положительной: %f", cg.SampleRate)
+	}
+	if cg.TotalTime <= 0 {
+		return fmt.Errorf("длительность должна быть положительной: %f", cg.TotalTime)
+	}
+	if cg.Amplitude <= 0 {
+		return fmt.Errorf("амплитуда должна быть положительной: %f", cg.Amplitude)
+	}
+	if cg.SweepType != LinearSweep && cg.SweepType != LogarithmicSweep {
+		return fmt.Errorf("неизвестный закон изменения частоты: %d", cg.SweepType)
+	}
+
+	// Проверка критерия Найквиста для обеих граничных частот
+	maxFreq := math.Max(cg.StartFrequency, cg.EndFrequency)
+	if maxFreq*2 >= cg.SampleRate {
+		return fmt.Errorf(
+			"нарушен критерий Найквиста: частота сигнала (%f Гц) должна быть меньше половины частоты дискретизации (%f Гц)",
+			maxFreq, cg.SampleRate/2,
+		)
+	}
+
+	return nil
+}
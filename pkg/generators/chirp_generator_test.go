@@ -0,0 +1,103 @@
+package generators
+
+import (
+	"math"
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"dsp_go/pkg/transform"
+)
+
+// measuredFrequency оценивает мгновенную частоту в отсчете i по приращению
+// фазы аналитического сигнала
+func measuredFrequency(signal []float64, i int, sampleRate float64) float64 {
+	analytic := transform.Hilbert(signal)
+	dphi := cmplx.Phase(analytic[i+1] * cmplx.Conj(analytic[i-1]))
+	return dphi / 2 * sampleRate / (2 * math.Pi)
+}
+
+// TestChirpGenerator_Midpoint проверяет мгновенную частоту в середине сигнала
+func TestChirpGenerator_Midpoint(t *testing.T) {
+	tests := []struct {
+		sweep    SweepType
+		expected float64
+	}{
+		{LinearSweep, 1050},                       // (f0+f1)/2
+		{LogarithmicSweep, math.Sqrt(100 * 2000)}, // sqrt(f0*f1)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sweep.String(), func(t *testing.T) {
+			gen := NewChirpGenerator()
+			gen.StartFrequency = 100
+			gen.EndFrequency = 2000
+			gen.SweepType = tt.sweep
+
+			signal, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Ошибка генерации: %v", err)
+			}
+			if len(signal) != 8000 {
+				t.Fatalf("Ожидалось 8000 отсчетов, получено %d", len(signal))
+			}
+
+			if f := gen.InstantaneousFrequency(gen.TotalTime / 2); math.Abs(f-tt.expected) > 1e-9 {
+				t.Errorf("InstantaneousFrequency: ожидалось %f, получено %f", tt.expected, f)
+			}
+
+			mid := len(signal) / 2
+			if f := measuredFrequency(signal, mid, gen.SampleRate); math.Abs(f-tt.expected) > 1 {
+				t.Errorf("Измеренная частота: ожидалось %.1f Гц, получено %.1f Гц", tt.expected, f)
+			}
+		})
+	}
+}
+
+// TestChirpGenerator_PhaseContinuity проверяет отсутствие скачков между отсчетами
+func TestChirpGenerator_PhaseContinuity(t *testing.T) {
+	gen := NewChirpGenerator()
+	gen.SweepType = LogarithmicSweep
+
+	signal, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Ошибка генерации: %v", err)
+	}
+
+	// Приращение синусоиды ограничено 2π·f_max/fs
+	maxStep := 2 * math.Pi * gen.EndFrequency / gen.SampleRate * gen.Amplitude
+	for i := 1; i < len(signal); i++ {
+		if step := math.Abs(signal[i] - signal[i-1]); step > maxStep+1e-9 {
+			t.Fatalf("Скачок в отсчете %d: %f > %f", i, step, maxStep)
+		}
+	}
+}
+
+// TestChirpGenerator_Validate проверяет ошибки валидации
+func TestChirpGenerator_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modifyGen   func(*ChirpGenerator)
+		errorSubstr string
+	}{
+		{"Zero start frequency", func(g *ChirpGenerator) { g.StartFrequency = 0 }, "частоты должны быть положительными"},
+		{"Nyquist violation", func(g *ChirpGenerator) { g.EndFrequency = 4000 }, "критерий Найквиста"},
+		{"Zero total time", func(g *ChirpGenerator) { g.TotalTime = 0 }, "длительность"},
+		{"Unknown sweep", func(g *ChirpGenerator) { g.SweepType = SweepType(5) }, "неизвестный закон"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := NewChirpGenerator()
+			tt.modifyGen(gen)
+
+			_, err := gen.Generate()
+			if err == nil {
+				t.Fatal("Ожидалась ошибка")
+			}
+			if !strings.Contains(err.Error(), tt.errorSubstr) {
+				t.Errorf("Ожидалась ошибка с %q, получено %q", tt.errorSubstr, err.Error())
+			}
+		})
+	}
+}
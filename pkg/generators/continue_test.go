@@ -0,0 +1,81 @@
+package generators
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGenerateContinuePhaseContinuity(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.Frequency = 437.0 // Не укладывается целым числом периодов в 0.5 с
+	gen.SampleRate = 8000.0
+	gen.TotalTime = 0.5
+
+	first, err := gen.GenerateContinue()
+	if err != nil {
+		t.Fatalf("GenerateContinue() error = %v", err)
+	}
+	second, err := gen.GenerateContinue()
+	if err != nil {
+		t.Fatalf("GenerateContinue() error = %v", err)
+	}
+	joined := append(append([]float64{}, first...), second...)
+
+	// Максимальная первая разность синусоиды: 2*A*sin(ω*T/2)
+	maxStep := 2 * gen.Amplitude * math.Sin(math.Pi*gen.Frequency/gen.SampleRate)
+
+	join := len(first)
+	if step := math.Abs(joined[join] - joined[join-1]); step > maxStep+1e-9 {
+		t.Errorf("Скачок на стыке %v превышает максимальную разность %v", step, maxStep)
+	}
+
+	// Вторая разность на стыке соответствует гладкой синусоиде: x'' = -ω²x
+	omega := 2 * math.Pi * gen.Frequency / gen.SampleRate
+	second2 := joined[join+1] - 2*joined[join] + joined[join-1]
+	expected := -2 * (1 - math.Cos(omega)) * joined[join]
+	if math.Abs(second2-expected) > 1e-9 {
+		t.Errorf("Вторая разность на стыке %v, ожидается %v", second2, expected)
+	}
+
+	// Склеенные фрагменты совпадают с одним сигналом двойной длины
+	gen.TotalTime = 1.0
+	whole, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	for i := range whole {
+		if math.Abs(whole[i]-joined[i]) > 1e-9 {
+			t.Fatalf("Отсчет %d: %v, ожидается %v", i, joined[i], whole[i])
+		}
+	}
+}
+
+func TestGenerateContinueReset(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.TotalTime = 0.01
+
+	first, _ := gen.GenerateContinue()
+	gen.GenerateContinue()
+
+	gen.ResetContinuation()
+	again, err := gen.GenerateContinue()
+	if err != nil {
+		t.Fatalf("GenerateContinue() error = %v", err)
+	}
+	for i := range first {
+		if again[i] != first[i] {
+			t.Fatalf("Отсчет %d после сброса: %v, ожидается %v", i, again[i], first[i])
+		}
+	}
+
+	// Ошибка параметров не сдвигает позицию
+	gen.ResetContinuation()
+	gen.Frequency = -1
+	if _, err := gen.GenerateContinue(); err == nil {
+		t.Fatal("Ожидалась ошибка для отрицательной частоты")
+	}
+	gen.Frequency = 1000.0
+	if again, _ := gen.GenerateContinue(); again[1] != first[1] {
+		t.Errorf("После ошибки позиция сдвинулась: %v, ожидается %v", again[1], first[1])
+	}
+}
@@ -0,0 +1,80 @@
+package generators
+
+import (
+	"fmt"
+	"math"
+)
+
+// ToneComponent описывает одну синусоидальную составляющую многотонального сигнала
+type ToneComponent struct {
+	Frequency float64 // Частота в герцах
+	Amplitude float64 // Амплитуда
+	Phase     float64 // Начальная фаза в радианах
+}
+
+// MultiTone генерирует сумму синусоид
+type MultiTone struct {
+	Components []ToneComponent // Составляющие сигнала
+	SampleRate float64         // Частота дискретизации в герцах
+	TotalTime  float64         // Длительность сигнала в секундах
+}
+
+// NewMultiTone создает генератор суммы синусоид с заданными составляющими
+func NewMultiTone(sampleRate, totalTime float64, components ...ToneComponent) *MultiTone {
+	return &MultiTone{
+		Components: append([]ToneComponent{}, components...),
+		SampleRate: sampleRate,
+		TotalTime:  totalTime,
+	}
+}
+
+// Generate создает массив отсчётов суммы синусоид
+// sum(A_i * sin(2π*f_i*t + φ_i))
+func (mt *MultiTone) Generate() ([]float64, error) {
+	if err := mt.validate(); err != nil {
+		return nil, err
+	}
+
+	numSamples := int(math.Round(mt.TotalTime * mt.SampleRate))
+	signals := make([]float64, numSamples)
+	timeStep := 1.0 / mt.SampleRate
+
+	for _, c := range mt.Components {
+		angularFreq := 2 * math.Pi * c.Frequency
+		for i := range signals {
+			signals[i] += c.Amplitude * math.Sin(angularFreq*float64(i)*timeStep+c.Phase)
+		}
+	}
+
+	return signals, nil
+}
+
+// validate проверяет корректность параметров
+func (mt *MultiTone) validate() error {
+	if len(mt.Components) == 0 {
+		return fmt.Errorf("не задано ни одной составляющей")
+	}
+	if mt.SampleRate <= 0 {
+		return fmt.Errorf("частота дискретизации должна быть положительной: %f", mt.SampleRate)
+	}
+	if mt.TotalTime <= 0 {
+		return fmt.Errorf("длительность должна быть положительной: %f", mt.TotalTime)
+	}
+
+	for i, c := range mt.Components {
+		if c.Frequency <= 0 {
+			return fmt.Errorf("составляющая %d: частота должна быть положительной: %f", i, c.Frequency)
+		}
+		if c.Amplitude <= 0 {
+			return fmt.Errorf("составляющая %d: амплитуда должна быть положительной: %f", i, c.Amplitude)
+		}
+		if c.Frequency*2 >= mt.SampleRate {
+			return fmt.Errorf(
+				"составляющая %d: нарушен критерий Найквиста: частота сигнала (%f Гц) должна быть меньше половины частоты дискретизации (%f Гц)",
+				i, c.Frequency, mt.SampleRate/2,
+			)
+		}
+	}
+
+	return nil
+}
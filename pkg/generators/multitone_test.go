@@ -0,0 +1,94 @@
+package generators
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"dsp_go/pkg/filters"
+)
+
+// TestMultiTone_GoertzelBank проверяет сигнал 1000 Гц + 0.5·2000 Гц набором фильтров Герцеля
+func TestMultiTone_GoertzelBank(t *testing.T) {
+	const fs = 8000.0
+	gen := NewMultiTone(fs, 0.1,
+		ToneComponent{Frequency: 1000, Amplitude: 1.0},
+		ToneComponent{Frequency: 2000, Amplitude: 0.5},
+	)
+
+	signal, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Ошибка генерации: %v", err)
+	}
+	if len(signal) != 800 {
+		t.Fatalf("Ожидалось 800 отсчетов, получено %d", len(signal))
+	}
+
+	freqs := []float64{1000, 1500, 2000}
+	bank, err := filters.NewGoertzelBank(freqs, fs, len(signal))
+	if err != nil {
+		t.Fatalf("Ошибка создания набора фильтров: %v", err)
+	}
+	if err := bank.ProcessBlock(signal); err != nil {
+		t.Fatalf("Ошибка обработки: %v", err)
+	}
+
+	expected := []float64{1.0, 0, 0.5}
+	for i, mag := range bank.Magnitudes() {
+		if math.Abs(mag-expected[i]) > 1e-6 {
+			t.Errorf("%.0f Гц: ожидалась амплитуда %f, получено %f", freqs[i], expected[i], mag)
+		}
+	}
+}
+
+// TestMultiTone_MatchesSum проверяет совпадение с суммой отдельных синусоид
+func TestMultiTone_MatchesSum(t *testing.T) {
+	components := []ToneComponent{
+		{Frequency: 440, Amplitude: 0.8, Phase: 0.3},
+		{Frequency: 1250, Amplitude: 0.2, Phase: -1},
+	}
+	signal, err := NewMultiTone(16000, 0.01, components...).Generate()
+	if err != nil {
+		t.Fatalf("Ошибка генерации: %v", err)
+	}
+
+	for i, v := range signal {
+		tm := float64(i) / 16000
+		var expected float64
+		for _, c := range components {
+			expected += c.Amplitude * math.Sin(2*math.Pi*c.Frequency*tm+c.Phase)
+		}
+		if math.Abs(v-expected) > 1e-12 {
+			t.Fatalf("Отсчет %d: ожидалось %f, получено %f", i, expected, v)
+		}
+	}
+}
+
+// TestMultiTone_Validate проверяет ошибки валидации
+func TestMultiTone_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		gen         *MultiTone
+		errorSubstr string
+	}{
+		{"No components", NewMultiTone(8000, 1), "не задано ни одной составляющей"},
+		{"Nyquist violation", NewMultiTone(8000, 1,
+			ToneComponent{Frequency: 1000, Amplitude: 1},
+			ToneComponent{Frequency: 4000, Amplitude: 1},
+		), "составляющая 1: нарушен критерий Найквиста"},
+		{"Zero amplitude", NewMultiTone(8000, 1, ToneComponent{Frequency: 1000}), "амплитуда"},
+		{"Zero sample rate", NewMultiTone(0, 1, ToneComponent{Frequency: 1000, Amplitude: 1}), "частота дискретизации"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.gen.Generate()
+			if err == nil {
+				t.Fatal("Ожидалась ошибка")
+			}
+			if !strings.Contains(err.Error(), tt.errorSubstr) {
+				t.Errorf("Ожидалась ошибка с %q, получено %q", tt.errorSubstr, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package generators
+
+import "math/rand"
+
+// NoiseType определяет тип генерируемого шума
+type NoiseType int
+
+const (
+	White    NoiseType = iota // Белый шум с равномерным распределением в [-1, 1]
+	Pink                      // Розовый шум (спад спектра 3 дБ/октаву)
+	Gaussian                  // Белый гауссов шум с единичной дисперсией
+)
+
+// String возвращает строковое представление типа шума
+func (nt NoiseType) String() string {
+	switch nt {
+	case White:
+		return "Белый"
+	case Pink:
+		return "Розовый"
+	case Gaussian:
+		return "Гауссов"
+	default:
+		return "Неизвестный"
+	}
+}
+
+// NoiseGenerator генерирует шумовые сигналы. При одинаковом зерне
+// генератор выдает одинаковые последовательности
+type NoiseGenerator struct {
+	NoiseType NoiseType // Тип шума
+	Amplitude float64   // Масштаб шума (для гауссова шума - СКО)
+
+	rng   *rand.Rand // Источник случайных чисел
+	pinkB [7]float64 // Состояние розовящего фильтра
+}
+
+// NewNoiseGenerator создает генератор шума заданного типа с единичной амплитудой
+func NewNoiseGenerator(noiseType NoiseType, seed int64) *NoiseGenerator {
+	return &NoiseGenerator{
+		NoiseType: noiseType,
+		Amplitude: 1.0,
+		rng:       rand.New(rand.NewSource(seed)),
+	}
+}
+
+// SetSeed задает зерно генератора и сбрасывает состояние розовящего фильтра
+func (ng *NoiseGenerator) SetSeed(seed int64) {
+	ng.rng = rand.New(rand.NewSource(seed))
+	ng.pinkB = [7]float64{}
+}
+
+// Generate возвращает n отсчетов шума. Состояние генератора сохраняется между
+// вызовами, поэтому последовательные блоки образуют непрерывный сигнал
+func (ng *NoiseGenerator) Generate(n int) []float64 {
+	if n < 0 {
+		panic("NoiseGenerator: sample count cannot be negative")
+	}
+
+	samples := make([]float64, n)
+	for i := range samples {
+		switch ng.NoiseType {
+		case White:
+			samples[i] = 2*ng.rng.Float64() - 1
+		case Gaussian:
+			samples[i] = ng.rng.NormFloat64()
+		case Pink:
+			samples[i] = ng.nextPink()
+		default:
+			panic("NoiseGenerator: unknown noise type")
+		}
+		samples[i] *= ng.Amplitude
+	}
+	return samples
+}
+
+// nextPink возвращает следующий отсчет розового шума. Используется розовящий
+// фильтр Пола Келлетта: сумма однополюсных ФНЧ, аппроксимирующая спад
+// 3 дБ/октаву с точностью ±0.05 дБ выше 0.0002·fs
+func (ng *NoiseGenerator) nextPink() float64 {
+	white := ng.rng.NormFloat64()
+	b := &ng.pinkB
+
+	b[0] = 0.99886*b[0] + white*0.0555179
+	b[1] = 0.99332*b[1] + white*0.0750759
+	b[2] = 0.96900*b[2] + white*0.1538520
+	b[3] = 0.86650*b[3] + white*0.3104856
+	b[4] = 0.55000*b[4] + white*0.5329522
+	b[5] = -0.7616*b[5] - white*0.0168980
+	pink := b[0] + b[1] + b[2] + b[3] + b[4] + b[5] + b[6] + white*0.5362
+	b[6] = white * 0.115926
+
+	// Приводим усиление фильтра примерно к единице
+	return pink * 0.11
+}
@@ -0,0 +1,100 @@
+package generators
+
+import (
+	"math"
+	"testing"
+
+	"dsp_go/pkg/spectral"
+)
+
+// bandPower возвращает среднее значение PSD в полосе [low, high) Гц
+func bandPower(freqs, psd []float64, low, high float64) float64 {
+	var sum float64
+	count := 0
+	for k, f := range freqs {
+		if f >= low && f < high {
+			sum += psd[k]
+			count++
+		}
+	}
+	return sum / float64(count)
+}
+
+// TestNoiseGenerator_WhiteFlat проверяет равномерность спектра белого шума
+func TestNoiseGenerator_WhiteFlat(t *testing.T) {
+	const fs = 1000.0
+
+	for _, noiseType := range []NoiseType{White, Gaussian} {
+		t.Run(noiseType.String(), func(t *testing.T) {
+			signal := NewNoiseGenerator(noiseType, 1).Generate(1 << 16)
+			freqs, psd := spectral.PSD(signal, 256, 128, nil, fs)
+
+			reference := bandPower(freqs, psd, 10, 490)
+			for low := 10.0; low < 490; low += 80 {
+				level := bandPower(freqs, psd, low, low+80)
+				if db := 10 * math.Log10(level/reference); math.Abs(db) > 0.5 {
+					t.Errorf("Полоса %.0f-%.0f Гц: отклонение %.2f дБ", low, low+80, db)
+				}
+			}
+		})
+	}
+}
+
+// TestNoiseGenerator_PinkSlope проверяет спад спектра розового шума на 3 дБ/октаву
+func TestNoiseGenerator_PinkSlope(t *testing.T) {
+	const fs = 48000.0
+	signal := NewNoiseGenerator(Pink, 2).Generate(1 << 18)
+	freqs, psd := spectral.PSD(signal, 4096, 2048, nil, fs)
+
+	// Сравниваем соседние октавы от 100 Гц до 12.8 кГц
+	previous := bandPower(freqs, psd, 100, 200)
+	for low := 200.0; low < 12800; low *= 2 {
+		current := bandPower(freqs, psd, low, 2*low)
+		slope := 10 * math.Log10(current/previous)
+		if math.Abs(slope+3) > 0.5 {
+			t.Errorf("Октава %.0f-%.0f Гц: спад %.2f дБ, ожидалось -3 дБ", low, 2*low, slope)
+		}
+		previous = current
+	}
+}
+
+// TestNoiseGenerator_Seed проверяет воспроизводимость последовательностей
+func TestNoiseGenerator_Seed(t *testing.T) {
+	for _, noiseType := range []NoiseType{White, Pink, Gaussian} {
+		first := NewNoiseGenerator(noiseType, 42).Generate(100)
+
+		gen := NewNoiseGenerator(noiseType, 7)
+		gen.Generate(10)
+		gen.SetSeed(42)
+		second := gen.Generate(100)
+
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("%s: отсчет %d различается: %f != %f", noiseType, i, first[i], second[i])
+			}
+		}
+	}
+}
+
+// TestNoiseGenerator_Statistics проверяет диапазон и дисперсию шума
+func TestNoiseGenerator_Statistics(t *testing.T) {
+	white := NewNoiseGenerator(White, 3)
+	white.Amplitude = 0.5
+	for i, v := range white.Generate(10000) {
+		if v < -0.5 || v > 0.5 {
+			t.Fatalf("Отсчет %d вне диапазона [-0.5, 0.5]: %f", i, v)
+		}
+	}
+
+	var sum, sumSq float64
+	samples := NewNoiseGenerator(Gaussian, 4).Generate(100000)
+	for _, v := range samples {
+		sum += v
+		sumSq += v * v
+	}
+	mean := sum / float64(len(samples))
+	variance := sumSq/float64(len(samples)) - mean*mean
+	if math.Abs(mean) > 0.02 || math.Abs(variance-1) > 0.02 {
+		t.Errorf("Гауссов шум: среднее %f, дисперсия %f", mean, variance)
+	}
+}
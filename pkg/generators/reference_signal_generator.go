@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"context"
 	"fmt"
 	"math"
 )
@@ -43,6 +44,14 @@ type ReferenceSignalGenerator struct {
 	Phase      float64    // Начальная фаза в радианах
 	SignalType SignalType // Тип сигнала
 	DutyCycle  float64    // Коэффициент заполнения (0.0 - 1.0) для прямоугольного сигнала
+	DCOffset   float64    // Постоянная составляющая, добавляемая к каждому отсчёту
+
+	// BandLimited включает генерацию прямоугольного и пилообразного сигналов
+	// суммой гармоник ниже частоты Найквиста (без наложения спектров).
+	// По умолчанию используются наивные формы с разрывами
+	BandLimited bool
+
+	nextIndex int // Индекс первого отсчёта следующего вызова GenerateContinue
 }
 
 // NewReferenceSignalGenerator создает новый генератор с настройками по умолчанию
@@ -55,11 +64,36 @@ func NewReferenceSignalGenerator() *ReferenceSignalGenerator {
 		Phase:      0.0,
 		SignalType: Sine,
 		DutyCycle:  0.5, // 50% заполнение по умолчанию
+		DCOffset:   0.0,
 	}
 }
 
 // Generate создает массив отсчётов сигнала
 func (rsg *ReferenceSignalGenerator) Generate() ([]float64, error) {
+	return rsg.generateFrom(0)
+}
+
+// GenerateContinue создает очередной фрагмент сигнала длительностью TotalTime,
+// продолжающий предыдущий без скачка фазы: индексы отсчётов продолжают расти
+// между вызовами, поэтому склеенные фрагменты совпадают с одним длинным сигналом.
+// Первый вызов совпадает с Generate; ResetContinuation начинает сигнал заново
+func (rsg *ReferenceSignalGenerator) GenerateContinue() ([]float64, error) {
+	signals, err := rsg.generateFrom(rsg.nextIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	rsg.nextIndex += len(signals)
+	return signals, nil
+}
+
+// ResetContinuation сбрасывает позицию GenerateContinue на начало сигнала
+func (rsg *ReferenceSignalGenerator) ResetContinuation() {
+	rsg.nextIndex = 0
+}
+
+// generateFrom создает TotalTime секунд сигнала, начиная с отсчёта start
+func (rsg *ReferenceSignalGenerator) generateFrom(start int) ([]float64, error) {
 	// Проверка входных параметров
 	if err := rsg.validate(); err != nil {
 		return nil, err
@@ -72,23 +106,129 @@ func (rsg *ReferenceSignalGenerator) Generate() ([]float64, error) {
 	timeStep := 1.0 / rsg.SampleRate
 	angularFreq := 2 * math.Pi * rsg.Frequency
 
-	// Генерация сигнала в зависимости от типа
 	for i := 0; i < numSamples; i++ {
-		time := float64(i) * timeStep
-		normalizedTime := rsg.Frequency * time // Время, нормированное на период
-
-		switch rsg.SignalType {
-		case Sine:
-			signals[i] = rsg.generateSine(angularFreq, time)
-		case Cosine:
-			signals[i] = rsg.generateCosine(angularFreq, time)
-		case Square:
-			signals[i] = rsg.generateSquare(normalizedTime)
-		case Sawtooth:
-			signals[i] = rsg.generateSawtooth(normalizedTime)
-		case Triangle:
-			signals[i] = rsg.generateTriangle(normalizedTime)
+		signals[i] = rsg.sampleAt(float64(start+i)*timeStep, angularFreq)
+	}
+
+	return signals, nil
+}
+
+// GenerateFunc создает массив отсчётов сигнала произвольной формы.
+// Функция shape получает дробную фазу в диапазоне [0, 1) (с учётом начальной фазы)
+// и возвращает значение сигнала единичной амплитуды; результат масштабируется
+// на Amplitude и смещается на DCOffset. Поле SignalType не используется
+func (rsg *ReferenceSignalGenerator) GenerateFunc(shape func(phase float64) float64) ([]float64, error) {
+	if shape == nil {
+		return nil, fmt.Errorf("функция формы сигнала не задана")
+	}
+	if err := rsg.validate(); err != nil {
+		return nil, err
+	}
+
+	numSamples := int(math.Round(rsg.TotalTime * rsg.SampleRate))
+	signals := make([]float64, numSamples)
+	timeStep := 1.0 / rsg.SampleRate
+
+	for i := 0; i < numSamples; i++ {
+		phase := rsg.Frequency*float64(i)*timeStep + rsg.Phase/(2*math.Pi)
+		fractionalPart := phase - math.Floor(phase)
+
+		signals[i] = rsg.Amplitude*shape(fractionalPart) + rsg.DCOffset
+	}
+
+	return signals, nil
+}
+
+// Stream генерирует бесконечный поток отсчётов сигнала (TotalTime не учитывается).
+// Отсчёты вычисляются по мере чтения с монотонно растущим индексом, поэтому фаза
+// остаётся непрерывной, а первые отсчёты совпадают с результатом Generate.
+// Канал закрывается при отмене контекста; при некорректных параметрах
+// канал закрывается сразу, не выдав ни одного отсчёта
+func (rsg *ReferenceSignalGenerator) Stream(ctx context.Context) <-chan float64 {
+	out := make(chan float64)
+
+	if err := rsg.validateWaveform(); err != nil {
+		close(out)
+		return out
+	}
+
+	// Копия параметров: изменение полей генератора не влияет на запущенный поток
+	params := *rsg
+	timeStep := 1.0 / params.SampleRate
+	angularFreq := 2 * math.Pi * params.Frequency
+
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- params.sampleAt(float64(i)*timeStep, angularFreq):
+			}
+		}
+	}()
+
+	return out
+}
+
+// sampleAt вычисляет отсчёт сигнала в момент времени time
+func (rsg *ReferenceSignalGenerator) sampleAt(time, angularFreq float64) float64 {
+	normalizedTime := rsg.Frequency * time // Время, нормированное на период
+
+	var sample float64
+	switch rsg.SignalType {
+	case Sine:
+		sample = rsg.generateSine(angularFreq, time)
+	case Cosine:
+		sample = rsg.generateCosine(angularFreq, time)
+	case Square:
+		if rsg.BandLimited {
+			sample = rsg.generateBandLimitedSquare(normalizedTime)
+		} else {
+			sample = rsg.generateSquare(normalizedTime)
+		}
+	case Sawtooth:
+		if rsg.BandLimited {
+			sample = rsg.generateBandLimitedSawtooth(normalizedTime)
+		} else {
+			sample = rsg.generateSawtooth(normalizedTime)
+		}
+	case Triangle:
+		sample = rsg.generateTriangle(normalizedTime)
+	}
+
+	return sample + rsg.DCOffset
+}
+
+// GenerateComplex создает массив комплексных (IQ) отсчётов сигнала.
+// Поддерживаются только типы Sine и Cosine: вещественная часть совпадает с
+// результатом Generate (включая DCOffset), мнимая - квадратурная составляющая.
+// Для Cosine отсчёты равны A*e^(j(ωt+φ)), для Sine - -j*A*e^(j(ωt+φ))
+func (rsg *ReferenceSignalGenerator) GenerateComplex() ([]complex128, error) {
+	if err := rsg.validate(); err != nil {
+		return nil, err
+	}
+	if rsg.SignalType != Sine && rsg.SignalType != Cosine {
+		return nil, fmt.Errorf("комплексный сигнал поддерживается только для синусоиды и косинусоиды: %s", rsg.SignalType)
+	}
+
+	numSamples := int(math.Round(rsg.TotalTime * rsg.SampleRate))
+	signals := make([]complex128, numSamples)
+
+	timeStep := 1.0 / rsg.SampleRate
+	angularFreq := 2 * math.Pi * rsg.Frequency
+
+	for i := 0; i < numSamples; i++ {
+		phase := angularFreq*float64(i)*timeStep + rsg.Phase
+		if rsg.SignalType == Sine {
+			// sin(θ) - j*cos(θ)
+			signals[i] = complex(rsg.Amplitude*math.Sin(phase), -rsg.Amplitude*math.Cos(phase))
+		} else {
+			// cos(θ) + j*sin(θ)
+			signals[i] = complex(rsg.Amplitude*math.Cos(phase), rsg.Amplitude*math.Sin(phase))
 		}
+
+		signals[i] += complex(rsg.DCOffset, 0)
 	}
 
 	return signals, nil
@@ -126,6 +266,41 @@ func (rsg *ReferenceSignalGenerator) generateSawtooth(normalizedTime float64) fl
 	return rsg.Amplitude * (2*fractionalPart - 1)
 }
 
+// harmonicCount возвращает число гармоник, лежащих ниже частоты Найквиста
+func (rsg *ReferenceSignalGenerator) harmonicCount() int {
+	count := int(rsg.SampleRate / (2 * rsg.Frequency))
+	if float64(count)*rsg.Frequency*2 >= rsg.SampleRate {
+		count-- // Гармоника точно на частоте Найквиста не включается
+	}
+	return count
+}
+
+// generateBandLimitedSquare генерирует прямоугольный сигнал суммой гармоник:
+// A(2D-1) + sum(2A/(πn) * (sin(2πnx) - sin(2πn(x-D))))
+func (rsg *ReferenceSignalGenerator) generateBandLimitedSquare(normalizedTime float64) float64 {
+	phase := normalizedTime + rsg.Phase/(2*math.Pi)
+
+	result := rsg.Amplitude * (2*rsg.DutyCycle - 1)
+	for n := 1; n <= rsg.harmonicCount(); n++ {
+		k := 2 * math.Pi * float64(n)
+		result += 2 * rsg.Amplitude / (math.Pi * float64(n)) *
+			(math.Sin(k*phase) - math.Sin(k*(phase-rsg.DutyCycle)))
+	}
+	return result
+}
+
+// generateBandLimitedSawtooth генерирует пилообразный сигнал суммой гармоник:
+// -sum(2A/(πn) * sin(2πnx))
+func (rsg *ReferenceSignalGenerator) generateBandLimitedSawtooth(normalizedTime float64) float64 {
+	phase := normalizedTime + rsg.Phase/(2*math.Pi)
+
+	var result float64
+	for n := 1; n <= rsg.harmonicCount(); n++ {
+		result -= 2 * rsg.Amplitude / (math.Pi * float64(n)) * math.Sin(2*math.Pi*float64(n)*phase)
+	}
+	return result
+}
+
 // generateTriangle генерирует треугольный сигнал
 func (rsg *ReferenceSignalGenerator) generateTriangle(normalizedTime float64) float64 {
 	// Фаза с учетом начальной фазы
@@ -144,21 +319,30 @@ func (rsg *ReferenceSignalGenerator) generateTriangle(normalizedTime float64) fl
 
 // validate проверяет корректность параметров
 func (rsg *ReferenceSignalGenerator) validate() error {
+	if rsg.TotalTime <= 0 {
+		return fmt.Errorf("длительность должна быть положительной: %f", rsg.TotalTime)
+	}
+
+	return rsg.validateWaveform()
+}
+
+// validateWaveform проверяет параметры формы сигнала без учёта длительности
+func (rsg *ReferenceSignalGenerator) validateWaveform() error {
 	if rsg.Frequency <= 0 {
 		return fmt.Errorf("частота должна быть положительной: %f", rsg.Frequency)
 	}
 	if rsg.SampleRate <= 0 {
 		return fmt.Errorf("частота дискретизации должна быть положительной: %f", rsg.SampleRate)
 	}
-	if rsg.TotalTime <= 0 {
-		return fmt.Errorf("длительность должна быть положительной: %f", rsg.TotalTime)
-	}
 	if rsg.Amplitude <= 0 {
 		return fmt.Errorf("амплитуда должна быть положительной: %f", rsg.Amplitude)
 	}
 	if rsg.DutyCycle <= 0 || rsg.DutyCycle >= 1 {
 		return fmt.Errorf("коэффициент заполнения должен быть в диапазоне (0, 1): %f", rsg.DutyCycle)
 	}
+	if math.IsNaN(rsg.DCOffset) || math.IsInf(rsg.DCOffset, 0) {
+		return fmt.Errorf("постоянная составляющая должна быть конечной: %f", rsg.DCOffset)
+	}
 
 	// Проверка критерия Найквиста
 	if rsg.Frequency*2 >= rsg.SampleRate {
@@ -176,7 +360,7 @@ func (rsg *ReferenceSignalGenerator) Info() string {
 	return fmt.Sprintf(
 		"Тип сигнала: %s\nЧастота: %.1f Гц\nЧастота дискретизации: %.1f Гц\n"+
 			"Длительность: %.1f с\nАмплитуда: %.1f\nНачальная фаза: %.2f рад\n"+
-			"Коэффициент заполнения: %.1f%%\nКоличество отсчётов: %d\n"+
+			"Коэффициент заполнения: %.1f%%\nПостоянная составляющая: %.2f\nКоличество отсчётов: %d\n"+
 			"Период сигнала: %.4f с (%.1f отсчётов)",
 		rsg.SignalType,
 		rsg.Frequency,
@@ -185,6 +369,7 @@ func (rsg *ReferenceSignalGenerator) Info() string {
 		rsg.Amplitude,
 		rsg.Phase,
 		rsg.DutyCycle*100,
+		rsg.DCOffset,
 		int(math.Round(rsg.TotalTime*rsg.SampleRate)),
 		1/rsg.Frequency,
 		rsg.SampleRate/rsg.Frequency,
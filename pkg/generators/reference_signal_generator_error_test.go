@@ -134,6 +134,29 @@ func TestValidateErrors(t *testing.T) {
 			expectError: true,
 			errorSubstr: "нарушен критерий Найквиста",
 		},
+		{
+			name: "Negative DC offset",
+			modifyGen: func(gen *ReferenceSignalGenerator) {
+				gen.DCOffset = -3.5
+			},
+			expectError: false,
+		},
+		{
+			name: "Infinite DC offset",
+			modifyGen: func(gen *ReferenceSignalGenerator) {
+				gen.DCOffset = math.Inf(1)
+			},
+			expectError: true,
+			errorSubstr: "постоянная составляющая должна быть конечной",
+		},
+		{
+			name: "NaN DC offset",
+			modifyGen: func(gen *ReferenceSignalGenerator) {
+				gen.DCOffset = math.NaN()
+			},
+			expectError: true,
+			errorSubstr: "постоянная составляющая должна быть конечной",
+		},
 		{
 			name: "Valid Nyquist case",
 			modifyGen: func(gen *ReferenceSignalGenerator) {
@@ -2,6 +2,7 @@ package generators
 
 import (
 	"math"
+	"math/cmplx"
 	"strings"
 	"testing"
 )
@@ -213,6 +214,136 @@ func TestGenerateTriangle(t *testing.T) {
 	}
 }
 
+func TestGenerateComplex(t *testing.T) {
+	for _, signalType := range []SignalType{Sine, Cosine} {
+		t.Run(signalType.String(), func(t *testing.T) {
+			gen := NewReferenceSignalGenerator()
+			gen.SignalType = signalType
+			gen.Amplitude = 2.5
+			gen.Phase = 0.4
+			gen.TotalTime = 0.01
+
+			samples, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			iq, err := gen.GenerateComplex()
+			if err != nil {
+				t.Fatalf("GenerateComplex() error = %v", err)
+			}
+			if len(iq) != len(samples) {
+				t.Fatalf("Длина: %d, ожидается %d", len(iq), len(samples))
+			}
+
+			step := 2 * math.Pi * gen.Frequency / gen.SampleRate
+			for i, z := range iq {
+				if math.Abs(cmplx.Abs(z)-gen.Amplitude) > 1e-12 {
+					t.Errorf("Отсчет %d: модуль %v, ожидается %v", i, cmplx.Abs(z), gen.Amplitude)
+				}
+				if math.Abs(real(z)-samples[i]) > 1e-12 {
+					t.Errorf("Отсчет %d: вещественная часть %v, ожидается %v", i, real(z), samples[i])
+				}
+				if i > 0 {
+					increment := cmplx.Phase(z * cmplx.Conj(iq[i-1]))
+					if math.Abs(increment-step) > 1e-12 {
+						t.Errorf("Отсчет %d: приращение фазы %v, ожидается %v", i, increment, step)
+					}
+				}
+			}
+		})
+	}
+
+	gen := NewReferenceSignalGenerator()
+	gen.SignalType = Square
+	if _, err := gen.GenerateComplex(); err == nil || !strings.Contains(err.Error(), "только для синусоиды") {
+		t.Errorf("Ожидалась ошибка для прямоугольного сигнала, получено %v", err)
+	}
+}
+
+func TestGenerateDCOffset(t *testing.T) {
+	for _, signalType := range []SignalType{Sine, Cosine, Square, Sawtooth, Triangle} {
+		t.Run(signalType.String(), func(t *testing.T) {
+			gen := NewReferenceSignalGenerator()
+			gen.SignalType = signalType
+			gen.Frequency = 100.0
+			gen.SampleRate = 8000.0
+			gen.TotalTime = 0.05 // Целое число периодов
+
+			plain, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			gen.DCOffset = 2.0
+			shifted, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			for i := range plain {
+				if math.Abs(shifted[i]-plain[i]-2.0) > 1e-12 {
+					t.Fatalf("Отсчет %d: смещение %v, ожидается 2", i, shifted[i]-plain[i])
+				}
+			}
+
+			if signalType == Sine {
+				var sum float64
+				for _, v := range shifted {
+					sum += v
+				}
+				if mean := sum / float64(len(shifted)); math.Abs(mean-2.0) > 1e-9 {
+					t.Errorf("Среднее значение = %v, ожидается 2", mean)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateFunc(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.Frequency = 300.0
+	gen.Amplitude = 1.5
+	gen.Phase = 0.7
+	gen.DCOffset = 0.2
+	gen.TotalTime = 0.1
+
+	expected, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	var minPhase, maxPhase float64 = 1, 0
+	custom, err := gen.GenerateFunc(func(phase float64) float64 {
+		minPhase = math.Min(minPhase, phase)
+		maxPhase = math.Max(maxPhase, phase)
+		return math.Sin(2 * math.Pi * phase)
+	})
+	if err != nil {
+		t.Fatalf("GenerateFunc() error = %v", err)
+	}
+
+	if len(custom) != len(expected) {
+		t.Fatalf("Длина: %d, ожидается %d", len(custom), len(expected))
+	}
+	for i := range expected {
+		if math.Abs(custom[i]-expected[i]) > 1e-9 {
+			t.Errorf("Отсчет %d: %v, ожидается %v", i, custom[i], expected[i])
+		}
+	}
+	if minPhase < 0 || maxPhase >= 1 {
+		t.Errorf("Фаза вне диапазона [0, 1): [%v, %v]", minPhase, maxPhase)
+	}
+
+	if _, err := gen.GenerateFunc(nil); err == nil {
+		t.Error("Ожидалась ошибка для nil функции")
+	}
+
+	gen.Frequency = 5000.0
+	if _, err := gen.GenerateFunc(math.Sin); err == nil || !strings.Contains(err.Error(), "Найквиста") {
+		t.Errorf("Ожидалась ошибка критерия Найквиста, получено %v", err)
+	}
+}
+
 func TestInfo(t *testing.T) {
 	gen := NewReferenceSignalGenerator()
 	gen.Frequency = 100.0
@@ -222,6 +353,7 @@ func TestInfo(t *testing.T) {
 	gen.Phase = math.Pi / 4
 	gen.SignalType = Square
 	gen.DutyCycle = 0.3
+	gen.DCOffset = -0.25
 
 	info := gen.Info()
 	if info == "" {
@@ -237,6 +369,7 @@ func TestInfo(t *testing.T) {
 		"Амплитуда",
 		"Начальная фаза",
 		"Коэффициент заполнения",
+		"Постоянная составляющая",
 		"Количество отсчётов",
 		"Период сигнала",
 	}
@@ -256,6 +389,7 @@ func TestInfo(t *testing.T) {
 		"2.5",           // Амплитуда
 		"0.79 рад",      // Начальная фаза (π/4 ≈ 0.785)
 		"30.0%",         // Коэффициент заполнения (0.3 * 100)
+		"-0.25",         // Постоянная составляющая
 		"2000",          // Количество отсчётов (2.0 * 1000.0 = 2000)
 	}
 
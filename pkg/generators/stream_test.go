@@ -0,0 +1,94 @@
+package generators
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestStreamMatchesGenerate(t *testing.T) {
+	for _, signalType := range []SignalType{Sine, Square, Triangle} {
+		t.Run(signalType.String(), func(t *testing.T) {
+			gen := NewReferenceSignalGenerator()
+			gen.SignalType = signalType
+			gen.Frequency = 440.0
+			gen.TotalTime = 0.5
+			gen.DCOffset = 0.1
+
+			expected, err := gen.Generate()
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stream := gen.Stream(ctx)
+
+			for i, want := range expected {
+				if got := <-stream; got != want {
+					cancel()
+					t.Fatalf("Отсчет %d: %v, ожидается %v", i, got, want)
+				}
+			}
+
+			// Поток не ограничен длительностью TotalTime
+			if _, ok := <-stream; !ok {
+				t.Error("Поток закрылся после TotalTime")
+			}
+
+			cancel()
+			waitClosed(t, stream)
+		})
+	}
+}
+
+func TestStreamInvalidParameters(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.Frequency = -1.0
+
+	waitClosed(t, gen.Stream(context.Background()))
+}
+
+func TestStreamIgnoresTotalTime(t *testing.T) {
+	gen := NewReferenceSignalGenerator()
+	gen.Frequency = 440.0
+	gen.TotalTime = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := gen.Stream(ctx)
+
+	timeStep := 1.0 / gen.SampleRate
+	angularFreq := 2 * math.Pi * gen.Frequency
+	for i := 0; i < 100; i++ {
+		got, ok := <-stream
+		if !ok {
+			cancel()
+			t.Fatalf("Поток закрылся на отсчёте %d при TotalTime = 0", i)
+		}
+		want := gen.sampleAt(float64(i)*timeStep, angularFreq)
+		if got != want {
+			cancel()
+			t.Fatalf("Отсчет %d: %v, ожидается %v", i, got, want)
+		}
+	}
+
+	cancel()
+	waitClosed(t, stream)
+}
+
+// waitClosed проверяет, что канал закрывается за разумное время
+func waitClosed(t *testing.T, stream <-chan float64) {
+	t.Helper()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-stream:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("Канал не закрылся после отмены контекста")
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Package meters реализует измерители уровня сигнала
+package meters
+
+import "math"
+
+// LevelMeter измеряет среднеквадратичный (RMS) и пиковый уровни сигнала.
+// RMS вычисляется по скользящему окну через скользящую сумму квадратов,
+// пиковое значение удерживается и экспоненциально спадает
+type LevelMeter struct {
+	buffer    []float64 // Кольцевой буфер квадратов отсчетов
+	pos       int       // Текущая позиция в буфере
+	count     int       // Количество отсчетов в буфере
+	sumSq     float64   // Скользящая сумма квадратов
+	peak      float64   // Текущее пиковое значение
+	peakDecay float64   // Множитель спада пика на каждом отсчете
+}
+
+// NewLevelMeter создает измеритель уровня.
+// windowLength - длина окна RMS в отсчетах, peakDecay - множитель (0 < peakDecay <= 1),
+// на который пиковое значение умножается на каждом отсчете, не превышающем его
+// (1 - удержание пика без спада)
+func NewLevelMeter(windowLength int, peakDecay float64) *LevelMeter {
+	if windowLength <= 0 {
+		panic("LevelMeter: window length must be positive")
+	}
+	if peakDecay <= 0 || peakDecay > 1 {
+		panic("LevelMeter: peak decay must be in range (0, 1]")
+	}
+
+	return &LevelMeter{
+		buffer:    make([]float64, windowLength),
+		peakDecay: peakDecay,
+	}
+}
+
+// Process обрабатывает один отсчет
+func (m *LevelMeter) Process(sample float64) {
+	sq := sample * sample
+	m.sumSq += sq - m.buffer[m.pos]
+	m.buffer[m.pos] = sq
+	m.pos = (m.pos + 1) % len(m.buffer)
+	if m.count < len(m.buffer) {
+		m.count++
+	}
+
+	// Пересчитываем сумму раз за проход буфера, чтобы не накапливать погрешность
+	if m.pos == 0 {
+		m.sumSq = 0
+		for _, v := range m.buffer {
+			m.sumSq += v
+		}
+	}
+
+	if level := math.Abs(sample); level > m.peak {
+		m.peak = level
+	} else {
+		m.peak *= m.peakDecay
+	}
+}
+
+// ProcessBlock обрабатывает блок отсчетов
+func (m *LevelMeter) ProcessBlock(samples []float64) {
+	for _, s := range samples {
+		m.Process(s)
+	}
+}
+
+// RMS возвращает среднеквадратичное значение по последним отсчетам окна.
+// До заполнения окна усреднение ведется по уже полученным отсчетам
+func (m *LevelMeter) RMS() float64 {
+	if m.count == 0 {
+		return 0
+	}
+	return math.Sqrt(math.Max(m.sumSq, 0) / float64(m.count))
+}
+
+// Peak возвращает текущее пиковое значение
+func (m *LevelMeter) Peak() float64 {
+	return m.peak
+}
+
+// Reset сбрасывает состояние измерителя
+func (m *LevelMeter) Reset() {
+	for i := range m.buffer {
+		m.buffer[i] = 0
+	}
+	m.pos = 0
+	m.count = 0
+	m.sumSq = 0
+	m.peak = 0
+}
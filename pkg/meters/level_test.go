@@ -0,0 +1,105 @@
+package meters
+
+import (
+	"math"
+	"testing"
+
+	"dsp_go/pkg/generators"
+)
+
+// TestLevelMeter_SineRMS проверяет RMS синусоиды единичной амплитуды
+func TestLevelMeter_SineRMS(t *testing.T) {
+	gen := generators.NewReferenceSignalGenerator()
+	gen.Frequency = 1000
+	gen.SampleRate = 48000
+	gen.TotalTime = 0.1
+
+	signal, err := gen.Generate()
+	if err != nil {
+		t.Fatalf("Ошибка генерации: %v", err)
+	}
+
+	// Окно кратно периоду (48 отсчетов)
+	meter := NewLevelMeter(480, 1)
+	meter.ProcessBlock(signal)
+
+	if rms := meter.RMS(); math.Abs(rms-1/math.Sqrt2) > 1e-6 {
+		t.Errorf("RMS: ожидалось %f, получено %f", 1/math.Sqrt2, rms)
+	}
+	if peak := meter.Peak(); math.Abs(peak-1) > 1e-3 {
+		t.Errorf("Пик: ожидалось 1, получено %f", peak)
+	}
+}
+
+// TestLevelMeter_SlidingWindow проверяет, что RMS учитывает только последние отсчеты окна
+func TestLevelMeter_SlidingWindow(t *testing.T) {
+	meter := NewLevelMeter(4, 1)
+
+	if meter.RMS() != 0 {
+		t.Errorf("RMS без отсчетов: ожидалось 0, получено %f", meter.RMS())
+	}
+
+	meter.ProcessBlock([]float64{3, 4})
+	if rms := meter.RMS(); math.Abs(rms-math.Sqrt(12.5)) > 1e-12 {
+		t.Errorf("Неполное окно: ожидалось %f, получено %f", math.Sqrt(12.5), rms)
+	}
+
+	meter.ProcessBlock([]float64{2, 2, 2, 2})
+	if rms := meter.RMS(); math.Abs(rms-2) > 1e-12 {
+		t.Errorf("После вытеснения: ожидалось 2, получено %f", rms)
+	}
+}
+
+// TestLevelMeter_PeakDecay проверяет удержание и спад пикового значения
+func TestLevelMeter_PeakDecay(t *testing.T) {
+	const decay = 0.9
+	meter := NewLevelMeter(8, decay)
+
+	meter.Process(-1)
+	if meter.Peak() != 1 {
+		t.Fatalf("Пик после отсчета -1: ожидалось 1, получено %f", meter.Peak())
+	}
+
+	for i := 1; i <= 10; i++ {
+		meter.Process(0.1)
+		expected := math.Pow(decay, float64(i))
+		if math.Abs(meter.Peak()-expected) > 1e-12 {
+			t.Errorf("Отсчет %d: ожидался пик %f, получено %f", i, expected, meter.Peak())
+		}
+	}
+
+	// Новый пик сразу перехватывает значение
+	meter.Process(0.8)
+	if meter.Peak() != 0.8 {
+		t.Errorf("Новый пик: ожидалось 0.8, получено %f", meter.Peak())
+	}
+
+	meter.Reset()
+	if meter.Peak() != 0 || meter.RMS() != 0 {
+		t.Errorf("После Reset: пик %f, RMS %f", meter.Peak(), meter.RMS())
+	}
+}
+
+// TestLevelMeter_InvalidParameters проверяет панику при некорректных параметрах
+func TestLevelMeter_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		length int
+		decay  float64
+	}{
+		{"Нулевая длина окна", 0, 0.9},
+		{"Нулевой спад", 10, 0},
+		{"Спад больше 1", 10, 1.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			_ = NewLevelMeter(tt.length, tt.decay)
+		})
+	}
+}
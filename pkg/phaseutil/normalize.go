@@ -0,0 +1,48 @@
+package phaseutil
+
+import "math"
+
+// NormalizePhaseRange приводит фазу к полуоткрытому диапазону [low, high)
+// прибавлением кратных периода high-low. Значения, уже лежащие в диапазоне,
+// возвращаются без изменений, поэтому повторная нормализация идемпотентна
+func NormalizePhaseRange(phase, low, high float64) float64 {
+	if !(high > low) {
+		panic("phaseutil: high must be greater than low")
+	}
+	if phase >= low && phase < high {
+		return phase
+	}
+
+	width := high - low
+	r := math.Mod(phase-low, width)
+	if r < 0 {
+		r += width
+	}
+
+	result := low + r
+	if result >= high {
+		// Округление у верхней границы
+		result = low
+	}
+	return result
+}
+
+// NormalizePhase приводит фазу к диапазону [-π, π): -π сохраняется, π переходит в -π.
+// Удобно при накоплении фазы, когда -π должно оставаться -π
+func NormalizePhase(phase float64) float64 {
+	return NormalizePhaseRange(phase, -math.Pi, math.Pi)
+}
+
+// NormalizePhaseUpper приводит фазу к диапазону (-π, π]: -π переходит в π.
+// Это соглашение math.Atan2 и детекторов пакета detectors
+func NormalizePhaseUpper(phase float64) float64 {
+	if phase > -math.Pi && phase <= math.Pi {
+		return phase
+	}
+
+	result := NormalizePhase(phase)
+	if result == -math.Pi {
+		return math.Pi
+	}
+	return result
+}
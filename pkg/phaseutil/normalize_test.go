@@ -0,0 +1,91 @@
+package phaseutil
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestNormalizePhase_Boundaries проверяет значения ±π и их кратные
+func TestNormalizePhase_Boundaries(t *testing.T) {
+	tests := []struct {
+		name         string
+		phase        float64
+		lower, upper float64 // Ожидания для [-π, π) и (-π, π]
+	}{
+		{"-π", -math.Pi, -math.Pi, math.Pi},
+		{"π", math.Pi, -math.Pi, math.Pi},
+		{"3π", 3 * math.Pi, -math.Pi, math.Pi},
+		{"-3π", -3 * math.Pi, -math.Pi, math.Pi},
+		{"2π", 2 * math.Pi, 0, 0},
+		{"-4π", -4 * math.Pi, 0, 0},
+		{"0", 0, 0, 0},
+		{"π/2 + 2π", math.Pi/2 + 2*math.Pi, math.Pi / 2, math.Pi / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizePhase(tt.phase); math.Abs(got-tt.lower) > 1e-12 {
+				t.Errorf("NormalizePhase: ожидалось %f, получено %f", tt.lower, got)
+			}
+			if got := NormalizePhaseUpper(tt.phase); math.Abs(got-tt.upper) > 1e-12 {
+				t.Errorf("NormalizePhaseUpper: ожидалось %f, получено %f", tt.upper, got)
+			}
+		})
+	}
+}
+
+// TestNormalizePhase_Idempotent проверяет, что повторная нормализация не меняет значение
+func TestNormalizePhase_Idempotent(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	values := []float64{-math.Pi, math.Pi, math.Nextafter(math.Pi, 0), math.Nextafter(-math.Pi, 0)}
+	for i := 0; i < 10000; i++ {
+		values = append(values, (rng.Float64()-0.5)*100)
+	}
+
+	for _, v := range values {
+		once := NormalizePhase(v)
+		if once < -math.Pi || once >= math.Pi {
+			t.Fatalf("NormalizePhase(%v) = %v вне [-π, π)", v, once)
+		}
+		if twice := NormalizePhase(once); twice != once {
+			t.Fatalf("NormalizePhase не идемпотентна для %v: %v -> %v", v, once, twice)
+		}
+
+		upper := NormalizePhaseUpper(v)
+		if upper <= -math.Pi || upper > math.Pi {
+			t.Fatalf("NormalizePhaseUpper(%v) = %v вне (-π, π]", v, upper)
+		}
+		if twice := NormalizePhaseUpper(upper); twice != upper {
+			t.Fatalf("NormalizePhaseUpper не идемпотентна для %v: %v -> %v", v, upper, twice)
+		}
+	}
+}
+
+// TestNormalizePhaseRange проверяет произвольный диапазон
+func TestNormalizePhaseRange(t *testing.T) {
+	tests := []struct {
+		phase, low, high, expected float64
+	}{
+		{7, 0, 2 * math.Pi, 7 - 2*math.Pi},
+		{-1, 0, 2 * math.Pi, 2*math.Pi - 1},
+		{2 * math.Pi, 0, 2 * math.Pi, 0},
+		{370, 0, 360, 10},
+		{-180, -180, 180, -180},
+		{180, -180, 180, -180},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizePhaseRange(tt.phase, tt.low, tt.high); math.Abs(got-tt.expected) > 1e-12 {
+			t.Errorf("NormalizePhaseRange(%v, %v, %v): ожидалось %v, получено %v",
+				tt.phase, tt.low, tt.high, tt.expected, got)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника для пустого диапазона")
+		}
+	}()
+	NormalizePhaseRange(1, 1, 1)
+}
@@ -0,0 +1,38 @@
+// Package phaseutil содержит общие функции работы с фазой: развертку
+// и приведение к заданному диапазону
+package phaseutil
+
+import "math"
+
+// Unwrap устраняет скачки фазы между соседними отсчетами, превышающие π по модулю,
+// добавляя к последующим отсчетам кратные 2π. Возвращает новый срез, первый
+// отсчет не изменяется. Приращение ровно ±π сохраняет свой знак
+func Unwrap(phases []float64) []float64 {
+	unwrapped := make([]float64, len(phases))
+	if len(phases) == 0 {
+		return unwrapped
+	}
+
+	unwrapped[0] = phases[0]
+	var correction float64
+	for i := 1; i < len(phases); i++ {
+		delta := phases[i] - phases[i-1]
+
+		// Приращение, приведенное к [-π, π)
+		wrapped := math.Mod(delta+math.Pi, 2*math.Pi)
+		if wrapped < 0 {
+			wrapped += 2 * math.Pi
+		}
+		wrapped -= math.Pi
+		if wrapped == -math.Pi && delta > 0 {
+			wrapped = math.Pi
+		}
+
+		if math.Abs(delta) >= math.Pi {
+			correction += wrapped - delta
+		}
+		unwrapped[i] = phases[i] + correction
+	}
+
+	return unwrapped
+}
@@ -0,0 +1,56 @@
+package phaseutil
+
+import (
+	"math"
+	"testing"
+)
+
+// wrap приводит фазу к диапазону (-π, π] через atan2
+func wrap(phase float64) float64 {
+	return math.Atan2(math.Sin(phase), math.Cos(phase))
+}
+
+// TestUnwrap_Ramp проверяет, что линейно растущая и убывающая фаза,
+// многократно свернутая в (-π, π], разворачивается в прямую
+func TestUnwrap_Ramp(t *testing.T) {
+	for _, slope := range []float64{0.3, -0.3, 2.5, -3} {
+		const n = 200
+		wrapped := make([]float64, n)
+		for i := range wrapped {
+			wrapped[i] = wrap(0.1 + slope*float64(i))
+		}
+
+		unwrapped := Unwrap(wrapped)
+		for i, p := range unwrapped {
+			if want := 0.1 + slope*float64(i); math.Abs(p-want) > 1e-9 {
+				t.Fatalf("Наклон %.1f, отсчет %d: ожидалось %f, получено %f", slope, i, want, p)
+			}
+		}
+	}
+}
+
+// TestUnwrap_PreservesInput проверяет, что входной срез не изменяется,
+// а гладкая фаза остается без изменений
+func TestUnwrap_PreservesInput(t *testing.T) {
+	phases := []float64{0, 3, -3, 0.5}
+	result := Unwrap(phases)
+
+	if phases[2] != -3 {
+		t.Error("Unwrap изменил входной срез")
+	}
+	// Переход 3 -> -3 (приращение -6) разворачивается в -3 + 2π
+	if math.Abs(result[2]-(-3+2*math.Pi)) > 1e-12 {
+		t.Errorf("Отсчет 2: ожидалось %f, получено %f", -3+2*math.Pi, result[2])
+	}
+
+	smooth := []float64{-1, -0.5, 0, 0.5, 1}
+	for i, p := range Unwrap(smooth) {
+		if p != smooth[i] {
+			t.Errorf("Гладкая фаза изменена в точке %d: %f", i, p)
+		}
+	}
+
+	if len(Unwrap(nil)) != 0 {
+		t.Error("Для пустого среза ожидался пустой результат")
+	}
+}
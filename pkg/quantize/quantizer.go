@@ -0,0 +1,137 @@
+// Package quantize реализует моделирование квантования для оценки поведения
+// алгоритмов на аппаратуре с фиксированной точкой
+package quantize
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Mode определяет способ приведения к уровню квантования
+type Mode int
+
+const (
+	// Round - округление к ближайшему уровню (ошибка в пределах ±LSB/2)
+	Round Mode = iota
+	// Truncate - отбрасывание младших разрядов в дополнительном коде,
+	// т.е. округление вниз (ошибка в пределах [0, LSB))
+	Truncate
+)
+
+// String возвращает строковое представление способа квантования
+func (m Mode) String() string {
+	switch m {
+	case Round:
+		return "Округление"
+	case Truncate:
+		return "Усечение"
+	default:
+		return "Неизвестный"
+	}
+}
+
+// Quantizer моделирует N-битный квантователь в дополнительном коде на
+// диапазоне [-fullScale, fullScale): 2^bits уровней с шагом 2*fullScale/2^bits.
+// Значения за пределами диапазона ограничиваются крайними уровнями
+type Quantizer struct {
+	bits      int        // Разрядность
+	fullScale float64    // Полная шкала (по модулю)
+	step      float64    // Шаг квантования (LSB)
+	minLevel  float64    // Минимальный номер уровня
+	maxLevel  float64    // Максимальный номер уровня
+	mode      Mode       // Способ квантования
+	rng       *rand.Rand // Генератор дизеринга (nil - дизеринг выключен)
+}
+
+// NewQuantizer создает квантователь с заданной разрядностью (1..53) и полной шкалой.
+// По умолчанию используется округление без дизеринга
+func NewQuantizer(bits int, fullScale float64) *Quantizer {
+	if bits < 1 || bits > 53 {
+		panic("Quantizer: bits must be between 1 and 53")
+	}
+	if fullScale <= 0 || math.IsInf(fullScale, 0) || math.IsNaN(fullScale) {
+		panic("Quantizer: full scale must be positive and finite")
+	}
+
+	levels := math.Ldexp(1, bits)
+	return &Quantizer{
+		bits:      bits,
+		fullScale: fullScale,
+		step:      2 * fullScale / levels,
+		minLevel:  -levels / 2,
+		maxLevel:  levels/2 - 1,
+		mode:      Round,
+	}
+}
+
+// SetMode задает способ квантования
+func (q *Quantizer) SetMode(mode Mode) {
+	if mode != Round && mode != Truncate {
+		panic("Quantizer: unknown mode")
+	}
+	q.mode = mode
+}
+
+// EnableDither включает треугольный (TPDF) дизеринг амплитудой ±1 LSB,
+// который декоррелирует ошибку квантования с сигналом
+func (q *Quantizer) EnableDither(seed int64) {
+	q.rng = rand.New(rand.NewSource(seed))
+}
+
+// DisableDither выключает дизеринг
+func (q *Quantizer) DisableDither() {
+	q.rng = nil
+}
+
+// Quantize квантует один отсчет
+func (q *Quantizer) Quantize(sample float64) float64 {
+	level := sample / q.step
+	if q.rng != nil {
+		level += q.rng.Float64() - q.rng.Float64()
+	}
+
+	switch q.mode {
+	case Truncate:
+		level = math.Floor(level)
+	default:
+		level = math.Round(level)
+	}
+
+	level = math.Max(q.minLevel, math.Min(q.maxLevel, level))
+	return level * q.step
+}
+
+// Process квантует весь срез входных данных
+func (q *Quantizer) Process(input []float64) []float64 {
+	output := make([]float64, len(input))
+	for i, val := range input {
+		output[i] = q.Quantize(val)
+	}
+	return output
+}
+
+// Step возвращает шаг квантования (вес младшего разряда)
+func (q *Quantizer) Step() float64 {
+	return q.step
+}
+
+// GetBits возвращает разрядность квантователя
+func (q *Quantizer) GetBits() int {
+	return q.bits
+}
+
+// GetFullScale возвращает полную шкалу квантователя
+func (q *Quantizer) GetFullScale() float64 {
+	return q.fullScale
+}
+
+// GetMode возвращает способ квантования
+func (q *Quantizer) GetMode() Mode {
+	return q.mode
+}
+
+// NoisePower возвращает теоретическую мощность шума квантования LSB²/12
+// (для округления без дизеринга)
+func (q *Quantizer) NoisePower() float64 {
+	return q.step * q.step / 12
+}
@@ -0,0 +1,113 @@
+package quantize
+
+import (
+	"math"
+	"testing"
+)
+
+// rampSignal возвращает плавно меняющийся сигнал внутри диапазона [-1, 1)
+func rampSignal(n int) []float64 {
+	signal := make([]float64, n)
+	for i := range signal {
+		signal[i] = 0.99 * math.Sin(0.0123*float64(i)+0.1)
+	}
+	return signal
+}
+
+// TestQuantizer_RoundStep проверяет шаг 8-битного квантования на [-1, 1]
+// и ошибку в пределах половины младшего разряда
+func TestQuantizer_RoundStep(t *testing.T) {
+	q := NewQuantizer(8, 1)
+
+	if step := q.Step(); step != 1.0/128 {
+		t.Fatalf("Шаг: ожидалось %f, получено %f", 1.0/128, step)
+	}
+
+	for _, x := range rampSignal(10000) {
+		y := q.Quantize(x)
+
+		// Результат лежит на сетке уровней
+		if level := y / q.Step(); level != math.Round(level) {
+			t.Fatalf("Значение %f не лежит на сетке уровней", y)
+		}
+		if err := math.Abs(y - x); err > q.Step()/2+1e-15 {
+			t.Fatalf("Ошибка %g превышает LSB/2 для %f", err, x)
+		}
+	}
+}
+
+// TestQuantizer_Truncate проверяет усечение: ошибка в пределах [0, LSB)
+func TestQuantizer_Truncate(t *testing.T) {
+	q := NewQuantizer(8, 1)
+	q.SetMode(Truncate)
+
+	for _, x := range rampSignal(10000) {
+		err := x - q.Quantize(x)
+		if err < 0 || err >= q.Step() {
+			t.Fatalf("Ошибка усечения %g вне [0, LSB) для %f", err, x)
+		}
+	}
+
+	if got := q.Quantize(-0.001); got != -q.Step() {
+		t.Errorf("Усечение -0.001: ожидалось %f, получено %f", -q.Step(), got)
+	}
+}
+
+// TestQuantizer_Clipping проверяет ограничение крайними уровнями
+func TestQuantizer_Clipping(t *testing.T) {
+	q := NewQuantizer(8, 1)
+
+	if got := q.Quantize(5); got != 1-q.Step() {
+		t.Errorf("Ограничение сверху: ожидалось %f, получено %f", 1-q.Step(), got)
+	}
+	if got := q.Quantize(-5); got != -1 {
+		t.Errorf("Ограничение снизу: ожидалось -1, получено %f", got)
+	}
+}
+
+// TestQuantizer_Dither проверяет, что дизеринг не смещает сигнал и
+// делает ошибку независимой от малого сигнала
+func TestQuantizer_Dither(t *testing.T) {
+	q := NewQuantizer(8, 1)
+	q.EnableDither(42)
+
+	// Постоянный сигнал в четверть шага без дизеринга квантуется в 0,
+	// с дизерингом - в среднем сохраняется
+	const n = 200000
+	x := q.Step() / 4
+	var sum float64
+	for i := 0; i < n; i++ {
+		y := q.Quantize(x)
+		if math.Abs(y-x) > 1.5*q.Step()+1e-15 {
+			t.Fatalf("Ошибка %g превышает 1.5 LSB", y-x)
+		}
+		sum += y
+	}
+
+	if mean := sum / n; math.Abs(mean-x) > 0.02*q.Step() {
+		t.Errorf("Среднее: ожидалось %g, получено %g", x, mean)
+	}
+
+	q.DisableDither()
+	if got := q.Quantize(x); got != 0 {
+		t.Errorf("Без дизеринга ожидалось 0, получено %g", got)
+	}
+}
+
+// TestQuantizer_NoisePower проверяет мощность шума квантования LSB²/12
+func TestQuantizer_NoisePower(t *testing.T) {
+	q := NewQuantizer(12, 1)
+	signal := rampSignal(100000)
+	output := q.Process(signal)
+
+	var power float64
+	for i := range signal {
+		e := output[i] - signal[i]
+		power += e * e
+	}
+	power /= float64(len(signal))
+
+	if math.Abs(power/q.NoisePower()-1) > 0.05 {
+		t.Errorf("Мощность шума: ожидалось %g, получено %g", q.NoisePower(), power)
+	}
+}
@@ -0,0 +1,58 @@
+package spectral
+
+import (
+	"math"
+	"testing"
+
+	"dsp_go/pkg/generators"
+)
+
+// TestDominantFrequency_OffBinTone проверяет оценку частоты и амплитуды тона между бинами
+func TestDominantFrequency_OffBinTone(t *testing.T) {
+	const (
+		fs = 8000.0
+		n  = 800 // Шаг бина 10 Гц
+	)
+	binWidth := fs / n
+
+	for _, freq := range []float64{1000, 1002.5, 1003.7, 1005, 2468.1} {
+		gen := generators.NewMultiTone(fs, n/fs,
+			generators.ToneComponent{Frequency: freq, Amplitude: 0.8, Phase: 0.3},
+			generators.ToneComponent{Frequency: 3100, Amplitude: 0.1},
+		)
+		signal, err := gen.Generate()
+		if err != nil {
+			t.Fatalf("Ошибка генерации: %v", err)
+		}
+
+		// Постоянная составляющая больше тона не учитывается
+		for i := range signal {
+			signal[i] += 2
+		}
+
+		gotFreq, gotAmp := DominantFrequency(signal, fs)
+		if math.Abs(gotFreq-freq) > 0.05*binWidth {
+			t.Errorf("%.1f Гц: частота %.3f Гц, ошибка больше 0.05 бина", freq, gotFreq)
+		}
+		if math.Abs(gotAmp-0.8) > 0.02*0.8 {
+			t.Errorf("%.1f Гц: амплитуда %.4f, ожидалось 0.8", freq, gotAmp)
+		}
+	}
+}
+
+// TestDominantFrequency_Degenerate проверяет тишину, короткий сигнал и неверную частоту дискретизации
+func TestDominantFrequency_Degenerate(t *testing.T) {
+	if f, a := DominantFrequency(make([]float64, 64), 1000); f != 0 || a != 0 {
+		t.Errorf("Тишина: ожидалось (0, 0), получено (%g, %g)", f, a)
+	}
+	if f, a := DominantFrequency([]float64{1, -1}, 1000); f != 0 || a != 0 {
+		t.Errorf("Короткий сигнал: ожидалось (0, 0), получено (%g, %g)", f, a)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Ожидалась паника при нулевой частоте дискретизации")
+		}
+	}()
+	DominantFrequency(make([]float64, 64), 0)
+}
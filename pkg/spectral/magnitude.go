@@ -0,0 +1,61 @@
+package spectral
+
+import (
+	"math/cmplx"
+
+	"dsp_go/pkg/fft"
+	"dsp_go/pkg/windows"
+)
+
+// MagnitudeSpectrum вычисляет односторонний амплитудный спектр сигнала.
+// Если window не равно nil, сигнал предварительно взвешивается окном той же
+// длины; без окна используется прямоугольное.
+//
+// Модули бинов нормированы на sum(w) = N * CG и удвоены (кроме нулевого бина
+// и бина Найквиста), поэтому синусоида амплитуды A, попавшая точно на бин,
+// дает пик высотой A, а постоянная составляющая C - значение C в нулевом бине.
+// Возвращаются len(signal)/2+1 частот от 0 до fs/2 в Гц и амплитуды.
+// Длина сигнала может быть любой (преобразование через fft.FFTAny)
+func MagnitudeSpectrum(signal []float64, fs float64, window []float64) (freqs, magnitudes []float64) {
+	n := len(signal)
+	if n == 0 {
+		panic("MagnitudeSpectrum: signal cannot be empty")
+	}
+	if fs <= 0 {
+		panic("MagnitudeSpectrum: sampling rate must be positive")
+	}
+	if window != nil && len(window) != n {
+		panic("MagnitudeSpectrum: window length must match signal length")
+	}
+
+	frame := make([]complex128, n)
+	for i, val := range signal {
+		if window != nil {
+			val *= window[i]
+		}
+		frame[i] = complex(val, 0)
+	}
+	spectrum := fft.FFTAny(frame)
+
+	gain := float64(n)
+	if window != nil {
+		gain *= windows.CoherentGain(window)
+	}
+	if gain == 0 {
+		panic("MagnitudeSpectrum: window has zero coherent gain")
+	}
+
+	bins := n/2 + 1
+	freqs = make([]float64, bins)
+	magnitudes = make([]float64, bins)
+	for k := range magnitudes {
+		magnitudes[k] = cmplx.Abs(spectrum[k]) / gain
+		// Односторонний спектр: удваиваем все бины, кроме нулевого и бина Найквиста
+		if k != 0 && !(n%2 == 0 && k == n/2) {
+			magnitudes[k] *= 2
+		}
+		freqs[k] = float64(k) * fs / float64(n)
+	}
+
+	return freqs, magnitudes
+}
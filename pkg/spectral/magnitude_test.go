@@ -0,0 +1,99 @@
+package spectral
+
+import (
+	"math"
+	"testing"
+
+	"dsp_go/pkg/windows"
+)
+
+// TestMagnitudeSpectrum_UnitSine проверяет положение и высоту пика единичной синусоиды
+func TestMagnitudeSpectrum_UnitSine(t *testing.T) {
+	const (
+		fs       = 1000.0
+		toneFreq = 125.0
+	)
+
+	// Длина 200 не является степенью двойки; тон попадает точно на бин 25
+	signal := make([]float64, 200)
+	for i := range signal {
+		signal[i] = math.Sin(2 * math.Pi * toneFreq * float64(i) / fs)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		window []float64
+	}{
+		{"без окна", nil},
+		{"Ханн", windows.HannWindow(len(signal))},
+		{"Хэмминг", windows.HammingWindow(len(signal))},
+	} {
+		freqs, mags := MagnitudeSpectrum(signal, fs, tc.window)
+		if len(freqs) != len(signal)/2+1 || len(mags) != len(freqs) {
+			t.Fatalf("%s: длина результата %d и %d, ожидалось %d", tc.name, len(freqs), len(mags), len(signal)/2+1)
+		}
+
+		peak := 0
+		for k := range mags {
+			if mags[k] > mags[peak] {
+				peak = k
+			}
+		}
+		if freqs[peak] != toneFreq {
+			t.Errorf("%s: пик на частоте %.1f Гц, ожидалось %.1f Гц", tc.name, freqs[peak], toneFreq)
+		}
+		if math.Abs(mags[peak]-1) > 1e-4 {
+			t.Errorf("%s: амплитуда пика %.6f, ожидалась 1", tc.name, mags[peak])
+		}
+
+		// Вне главного лепестка окна спектр пуст (симметричные окна дают
+		// небольшую утечку)
+		for k := range mags {
+			if abs(k-peak) > 2 && mags[k] > 1e-3 {
+				t.Errorf("%s: бин %d: паразитная амплитуда %g", tc.name, k, mags[k])
+				break
+			}
+		}
+	}
+}
+
+// TestMagnitudeSpectrum_DC проверяет масштаб постоянной составляющей
+func TestMagnitudeSpectrum_DC(t *testing.T) {
+	signal := make([]float64, 64)
+	for i := range signal {
+		signal[i] = 0.5
+	}
+
+	freqs, mags := MagnitudeSpectrum(signal, 8000, windows.HannWindow(len(signal)))
+	if freqs[0] != 0 || freqs[len(freqs)-1] != 4000 {
+		t.Errorf("Ось частот: ожидалось 0..4000 Гц, получено %.1f..%.1f", freqs[0], freqs[len(freqs)-1])
+	}
+	if math.Abs(mags[0]-0.5) > 1e-12 {
+		t.Errorf("Постоянная составляющая: ожидалось 0.5, получено %f", mags[0])
+	}
+}
+
+// TestMagnitudeSpectrum_InvalidParameters проверяет панику при неверных параметрах
+func TestMagnitudeSpectrum_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		signal []float64
+		fs     float64
+		window []float64
+	}{
+		{"Пустой сигнал", nil, 1000, nil},
+		{"Нулевая частота дискретизации", []float64{1, 2}, 0, nil},
+		{"Длина окна", []float64{1, 2}, 1000, []float64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			MagnitudeSpectrum(tt.signal, tt.fs, tt.window)
+		})
+	}
+}
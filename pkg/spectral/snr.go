@@ -0,0 +1,149 @@
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"dsp_go/pkg/fft"
+	"dsp_go/pkg/windows"
+)
+
+const (
+	// toneSkirtBins - полуширина области бинов, относимых к тону
+	// (главный лепесток окна Блэкмана-Харриса занимает ±4 бина)
+	toneSkirtBins = 5
+	// harmonicCount - число учитываемых гармоник, начиная со второй
+	harmonicCount = 5
+)
+
+// SNR оценивает отношение сигнал/шум (дБ) для записи одиночного тона частоты
+// fundamentalHz. Сигнал взвешивается окном Блэкмана-Харриса и преобразуется БПФ;
+// мощность тона - сумма бинов вокруг его пика, постоянная составляющая и
+// гармоники 2..6 (с учетом наложения спектров) исключаются. Мощность шума
+// оценивается по средней мощности оставшихся бинов, распространенной на всю полосу
+func SNR(signal []float64, fundamentalHz, fs float64) float64 {
+	a := analyzeTone(signal, fundamentalHz, fs)
+
+	noiseBins := 0
+	var noise float64
+	for k, p := range a.power {
+		if !a.excluded(k) {
+			noise += p
+			noiseBins++
+		}
+	}
+	if noiseBins == 0 || noise == 0 {
+		return math.Inf(1)
+	}
+
+	// Шум под исключенными бинами оценивается по средней плотности
+	totalNoise := noise / float64(noiseBins) * float64(len(a.power)-a.dcBins)
+	return 10 * math.Log10(a.tonePower/totalNoise)
+}
+
+// SINAD оценивает отношение сигнал/(шум + искажения) (дБ) для записи одиночного
+// тона частоты fundamentalHz: мощность тона сравнивается с мощностью всех
+// остальных бинов, кроме постоянной составляющей, включая гармоники
+func SINAD(signal []float64, fundamentalHz, fs float64) float64 {
+	a := analyzeTone(signal, fundamentalHz, fs)
+
+	var rest float64
+	for k := a.dcBins; k < len(a.power); k++ {
+		if !a.inTone(k) {
+			rest += a.power[k]
+		}
+	}
+	if rest == 0 {
+		return math.Inf(1)
+	}
+
+	return 10 * math.Log10(a.tonePower/rest)
+}
+
+// toneAnalysis содержит спектр мощности и разметку бинов для SNR и SINAD
+type toneAnalysis struct {
+	power     []float64 // Односторонний спектр мощности
+	dcBins    int       // Число бинов постоянной составляющей
+	toneBin   int       // Бин пика тона
+	harmonics []int     // Бины гармоник (после наложения)
+	tonePower float64   // Мощность тона
+}
+
+// inTone сообщает, относится ли бин k к основному тону
+func (a *toneAnalysis) inTone(k int) bool {
+	return abs(k-a.toneBin) <= toneSkirtBins
+}
+
+// excluded сообщает, исключается ли бин k из оценки шума
+func (a *toneAnalysis) excluded(k int) bool {
+	if k < a.dcBins || a.inTone(k) {
+		return true
+	}
+	for _, h := range a.harmonics {
+		if abs(k-h) <= toneSkirtBins {
+			return true
+		}
+	}
+	return false
+}
+
+// analyzeTone вычисляет спектр мощности и находит бины тона и его гармоник
+func analyzeTone(signal []float64, fundamentalHz, fs float64) *toneAnalysis {
+	if fs <= 0 {
+		panic("SNR: sampling rate must be positive")
+	}
+	if fundamentalHz <= 0 || fundamentalHz >= fs/2 {
+		panic("SNR: fundamental frequency must be in range (0, fs/2)")
+	}
+	n := len(signal)
+	if n < 8*toneSkirtBins {
+		panic("SNR: signal is too short")
+	}
+
+	window := windows.Get(windows.BlackmanHarris)(n)
+	buffer := make([]complex128, n)
+	for i, v := range signal {
+		buffer[i] = complex(v*window[i], 0)
+	}
+	spectrum := fft.FFTAny(buffer)
+
+	a := &toneAnalysis{
+		power:  make([]float64, n/2+1),
+		dcBins: toneSkirtBins,
+	}
+	for k := range a.power {
+		mag := cmplx.Abs(spectrum[k])
+		a.power[k] = mag * mag
+	}
+
+	// Уточняем положение тона по максимуму вблизи ожидаемого бина
+	expected := int(math.Round(fundamentalHz * float64(n) / fs))
+	a.toneBin = expected
+	for k := max(a.dcBins, expected-toneSkirtBins); k <= min(len(a.power)-1, expected+toneSkirtBins); k++ {
+		if a.power[k] > a.power[a.toneBin] {
+			a.toneBin = k
+		}
+	}
+	for k := max(0, a.toneBin-toneSkirtBins); k <= min(len(a.power)-1, a.toneBin+toneSkirtBins); k++ {
+		a.tonePower += a.power[k]
+	}
+
+	// Гармоники с учетом наложения в диапазон [0, fs/2]
+	for h := 2; h <= harmonicCount+1; h++ {
+		freq := math.Mod(float64(h)*fundamentalHz, fs)
+		if freq > fs/2 {
+			freq = fs - freq
+		}
+		a.harmonics = append(a.harmonics, int(math.Round(freq*float64(n)/fs)))
+	}
+
+	return a
+}
+
+// abs возвращает модуль целого числа
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
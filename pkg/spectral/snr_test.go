@@ -0,0 +1,75 @@
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// noisyTone возвращает синусоиду единичной амплитуды с заданным отношением
+// сигнал/шум (дБ), опционально с третьей гармоникой
+func noisyTone(n int, freq, fs, snrDB, thirdHarmonic float64, seed int64) []float64 {
+	rng := rand.New(rand.NewSource(seed))
+	noiseStd := math.Sqrt(0.5 / math.Pow(10, snrDB/10))
+
+	signal := make([]float64, n)
+	for i := range signal {
+		phase := 2 * math.Pi * freq * float64(i) / fs
+		signal[i] = math.Sin(phase) + thirdHarmonic*math.Sin(3*phase) + noiseStd*rng.NormFloat64()
+	}
+	return signal
+}
+
+// TestSNR_KnownNoise проверяет измерение заданного отношения сигнал/шум
+func TestSNR_KnownNoise(t *testing.T) {
+	for _, snrDB := range []float64{20, 40, 60} {
+		signal := noisyTone(8192, 1000, 48000, snrDB, 0, 1)
+
+		if got := SNR(signal, 1000, 48000); math.Abs(got-snrDB) > 1 {
+			t.Errorf("SNR: ожидалось %.1f дБ, получено %.2f дБ", snrDB, got)
+		}
+		// Без гармоник SINAD совпадает с SNR
+		if got := SINAD(signal, 1000, 48000); math.Abs(got-snrDB) > 1 {
+			t.Errorf("SINAD: ожидалось %.1f дБ, получено %.2f дБ", snrDB, got)
+		}
+	}
+}
+
+// TestSINAD_IncludesHarmonics проверяет, что SINAD учитывает гармоники, а SNR - нет
+func TestSINAD_IncludesHarmonics(t *testing.T) {
+	// Третья гармоника амплитудой 0.01: искажения -40 дБ, шум -70 дБ
+	signal := noisyTone(8192, 1000, 48000, 70, 0.01, 2)
+
+	if got := SNR(signal, 1000, 48000); math.Abs(got-70) > 2 {
+		t.Errorf("SNR: ожидалось 70 дБ, получено %.2f дБ", got)
+	}
+
+	expectedSINAD := 10 * math.Log10(0.5/(0.5e-4+0.5e-7))
+	if got := SINAD(signal, 1000, 48000); math.Abs(got-expectedSINAD) > 1 {
+		t.Errorf("SINAD: ожидалось %.2f дБ, получено %.2f дБ", expectedSINAD, got)
+	}
+}
+
+// TestSNR_InvalidParameters проверяет панику при некорректных параметрах
+func TestSNR_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		signal   []float64
+		freq, fs float64
+	}{
+		{"Частота выше Найквиста", make([]float64, 1024), 30000, 48000},
+		{"Нулевая частота дискретизации", make([]float64, 1024), 1000, 0},
+		{"Короткий сигнал", make([]float64, 10), 1000, 48000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			SNR(tt.signal, tt.freq, tt.fs)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package spectral
+
+import (
+	"math/cmplx"
+
+	"dsp_go/pkg/fft"
+)
+
+// Spectrogram вычисляет кратковременное преобразование Фурье (STFT) сигнала.
+// Окно длины len(window) сдвигается по сигналу с шагом hop отсчетов; каждый
+// кадр взвешивается окном и преобразуется БПФ.
+//
+// Возвращает матрицу модулей спектра magnitudes[кадр][бин] с len(window)/2+1
+// бинами в каждом кадре, ось частот бинов в Гц и ось времени кадров в секундах
+// (время центра кадра)
+func Spectrogram(signal []float64, window []float64, hop int, fs float64) ([][]float64, []float64, []float64) {
+	segLen := len(window)
+	if segLen == 0 {
+		panic("Spectrogram: window cannot be empty")
+	}
+	if hop <= 0 {
+		panic("Spectrogram: hop must be positive")
+	}
+	if fs <= 0 {
+		panic("Spectrogram: sampling rate must be positive")
+	}
+
+	bins := segLen/2 + 1
+	freqs := make([]float64, bins)
+	for k := range freqs {
+		freqs[k] = float64(k) * fs / float64(segLen)
+	}
+
+	magnitudes := [][]float64{}
+	times := []float64{}
+	frame := make([]complex128, segLen)
+
+	for start := 0; start+segLen <= len(signal); start += hop {
+		for i := range frame {
+			frame[i] = complex(signal[start+i]*window[i], 0)
+		}
+		spectrum := fft.FFTAny(frame)
+
+		row := make([]float64, bins)
+		for k := range row {
+			row[k] = cmplx.Abs(spectrum[k])
+		}
+		magnitudes = append(magnitudes, row)
+		times = append(times, (float64(start)+float64(segLen)/2)/fs)
+	}
+
+	return magnitudes, freqs, times
+}
@@ -0,0 +1,71 @@
+package spectral
+
+import (
+	"math"
+	"testing"
+
+	"dsp_go/pkg/windows"
+)
+
+// TestSpectrogram_TwoTones проверяет две горизонтальные линии на частотах тонов
+func TestSpectrogram_TwoTones(t *testing.T) {
+	const (
+		fs     = 8000.0
+		segLen = 256
+		hop    = 64
+	)
+	f1, f2 := 500.0, 2000.0 // Бины 16 и 64
+
+	signal := make([]float64, 4096)
+	for i := range signal {
+		tm := float64(i) / fs
+		signal[i] = math.Sin(2*math.Pi*f1*tm) + 0.5*math.Sin(2*math.Pi*f2*tm)
+	}
+
+	window := windows.Get(windows.Hann)(segLen)
+	magnitudes, freqs, times := Spectrogram(signal, window, hop, fs)
+
+	expectedFrames := (len(signal)-segLen)/hop + 1
+	if len(magnitudes) != expectedFrames || len(times) != expectedFrames {
+		t.Fatalf("Кадров: ожидалось %d, получено %d (ось времени %d)", expectedFrames, len(magnitudes), len(times))
+	}
+	if len(freqs) != segLen/2+1 {
+		t.Fatalf("Бинов: ожидалось %d, получено %d", segLen/2+1, len(freqs))
+	}
+	if math.Abs(times[0]-float64(segLen)/2/fs) > 1e-12 || math.Abs(times[1]-times[0]-hop/fs) > 1e-12 {
+		t.Errorf("Неверная ось времени: %v", times[:2])
+	}
+
+	bin1 := int(f1 * segLen / fs)
+	bin2 := int(f2 * segLen / fs)
+	for frame, row := range magnitudes {
+		// Два наибольших локальных максимума находятся на бинах тонов
+		for k := 1; k < len(row)-1; k++ {
+			if k == bin1 || k == bin2 {
+				if row[k] < row[k-1] || row[k] < row[k+1] {
+					t.Fatalf("Кадр %d: бин %d не является локальным максимумом", frame, k)
+				}
+				continue
+			}
+			if math.Abs(float64(k-bin1)) > 2 && math.Abs(float64(k-bin2)) > 2 && row[k] > 0.01*row[bin2] {
+				t.Fatalf("Кадр %d: лишняя энергия в бине %d (%f)", frame, k, row[k])
+			}
+		}
+
+		// Отношение амплитуд тонов сохраняется
+		if ratio := row[bin2] / row[bin1]; math.Abs(ratio-0.5) > 1e-3 {
+			t.Errorf("Кадр %d: отношение амплитуд %f, ожидалось 0.5", frame, ratio)
+		}
+	}
+}
+
+// TestSpectrogram_ShortSignal проверяет пустой результат для сигнала короче окна
+func TestSpectrogram_ShortSignal(t *testing.T) {
+	magnitudes, freqs, times := Spectrogram(make([]float64, 10), make([]float64, 16), 4, 1000)
+	if len(magnitudes) != 0 || len(times) != 0 {
+		t.Errorf("Ожидалось 0 кадров, получено %d", len(magnitudes))
+	}
+	if len(freqs) != 9 {
+		t.Errorf("Бинов: ожидалось 9, получено %d", len(freqs))
+	}
+}
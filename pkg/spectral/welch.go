@@ -0,0 +1,73 @@
+// Package spectral реализует методы спектрального анализа сигналов
+package spectral
+
+import (
+	"math/cmplx"
+
+	"dsp_go/pkg/fft"
+	"dsp_go/pkg/windows"
+)
+
+// PSD оценивает одностороннюю спектральную плотность мощности сигнала методом Уэлча.
+// Сигнал разбивается на сегменты длины segLen, перекрывающиеся на overlap отсчетов;
+// каждый сегмент взвешивается окном, преобразуется БПФ, и периодограммы усредняются.
+//
+// Результат нормирован на частоту дискретизации fs и на мощность окна
+// sum(w^2) = ENBW * N * CG^2, поэтому измеряется в единицах²/Гц: интеграл PSD
+// по частоте равен средней мощности сигнала. Если window равно nil, используется
+// окно Ханна. Возвращаются segLen/2+1 частот от 0 до fs/2 и соответствующие значения PSD
+func PSD(signal []float64, segLen, overlap int, window []float64, fs float64) (freqs, psd []float64) {
+	if segLen <= 0 {
+		panic("PSD: segment length must be positive")
+	}
+	if overlap < 0 || overlap >= segLen {
+		panic("PSD: overlap must be in range [0, segLen)")
+	}
+	if fs <= 0 {
+		panic("PSD: sampling rate must be positive")
+	}
+	if len(signal) < segLen {
+		panic("PSD: signal is shorter than one segment")
+	}
+	if window == nil {
+		window = windows.Get(windows.Hann)(segLen)
+	}
+	if len(window) != segLen {
+		panic("PSD: window length must match segment length")
+	}
+
+	bins := segLen/2 + 1
+	psd = make([]float64, bins)
+	segment := make([]complex128, segLen)
+	step := segLen - overlap
+	segments := 0
+
+	for start := 0; start+segLen <= len(signal); start += step {
+		for i := range segment {
+			segment[i] = complex(signal[start+i]*window[i], 0)
+		}
+		spectrum := fft.FFTAny(segment)
+		for k := 0; k < bins; k++ {
+			mag := cmplx.Abs(spectrum[k])
+			psd[k] += mag * mag
+		}
+		segments++
+	}
+
+	// Мощность окна через его метрики: sum(w^2) = ENBW * N * CG^2
+	cg := windows.CoherentGain(window)
+	windowPower := windows.EquivalentNoiseBandwidth(window) * float64(segLen) * cg * cg
+	scale := 1 / (fs * windowPower * float64(segments))
+
+	freqs = make([]float64, bins)
+	for k := range psd {
+		psd[k] *= scale
+		// Односторонний спектр: удваиваем все бины, кроме нулевого и бина Найквиста
+		if k != 0 && !(segLen%2 == 0 && k == segLen/2) {
+			psd[k] *= 2
+		}
+		freqs[k] = float64(k) * fs / float64(segLen)
+	}
+
+	return freqs, psd
+}
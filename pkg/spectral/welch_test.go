@@ -0,0 +1,118 @@
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestPSD_SineTone проверяет мощность синусоиды известной амплитуды и уровень шума
+func TestPSD_SineTone(t *testing.T) {
+	const (
+		fs        = 8000.0
+		toneFreq  = 1000.0
+		amplitude = 2.0
+		noiseStd  = 0.1
+		segLen    = 256
+	)
+
+	rng := rand.New(rand.NewSource(1))
+	signal := make([]float64, 16384)
+	for i := range signal {
+		signal[i] = amplitude*math.Sin(2*math.Pi*toneFreq*float64(i)/fs) + noiseStd*rng.NormFloat64()
+	}
+
+	freqs, psd := PSD(signal, segLen, segLen/2, nil, fs)
+	if len(freqs) != segLen/2+1 || len(psd) != len(freqs) {
+		t.Fatalf("Длина результата: ожидалось %d, получено %d и %d", segLen/2+1, len(freqs), len(psd))
+	}
+	df := freqs[1] - freqs[0]
+
+	// Пик находится на частоте тона
+	peak := 0
+	for k := range psd {
+		if psd[k] > psd[peak] {
+			peak = k
+		}
+	}
+	if math.Abs(freqs[peak]-toneFreq) > df/2 {
+		t.Errorf("Пик на частоте %.1f Гц, ожидалось %.1f Гц", freqs[peak], toneFreq)
+	}
+
+	// Мощность в окрестности пика равна A^2/2
+	var tonePower float64
+	for k := peak - 3; k <= peak+3; k++ {
+		tonePower += psd[k] * df
+	}
+	expected := amplitude * amplitude / 2
+	if math.Abs(tonePower-expected)/expected > 0.02 {
+		t.Errorf("Мощность тона: ожидалось %.4f, получено %.4f", expected, tonePower)
+	}
+
+	// Уровень белого шума вдали от тона: 2σ²/fs
+	expectedFloor := 2 * noiseStd * noiseStd / fs
+	var floor float64
+	count := 0
+	for k := 5; k < peak-10; k++ {
+		floor += psd[k]
+		count++
+	}
+	floor /= float64(count)
+	if math.Abs(floor-expectedFloor)/expectedFloor > 0.2 {
+		t.Errorf("Уровень шума: ожидалось %.3e, получено %.3e", expectedFloor, floor)
+	}
+}
+
+// TestPSD_Parseval проверяет, что интеграл PSD равен средней мощности сигнала
+func TestPSD_Parseval(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	signal := make([]float64, 10000)
+	var power float64
+	for i := range signal {
+		signal[i] = rng.NormFloat64()
+		power += signal[i] * signal[i]
+	}
+	power /= float64(len(signal))
+
+	// Прямоугольное окно и длина сегмента, не являющаяся степенью двойки
+	window := make([]float64, 250)
+	for i := range window {
+		window[i] = 1
+	}
+	freqs, psd := PSD(signal, 250, 0, window, 1000)
+
+	var integral float64
+	for _, p := range psd {
+		integral += p * (freqs[1] - freqs[0])
+	}
+	if math.Abs(integral-power)/power > 0.05 {
+		t.Errorf("Интеграл PSD: ожидалось %.4f, получено %.4f", power, integral)
+	}
+}
+
+// TestPSD_InvalidParameters проверяет панику при некорректных параметрах
+func TestPSD_InvalidParameters(t *testing.T) {
+	signal := make([]float64, 100)
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Нулевая длина сегмента", func() { PSD(signal, 0, 0, nil, 1) }},
+		{"Перекрытие равно длине сегмента", func() { PSD(signal, 10, 10, nil, 1) }},
+		{"Отрицательная частота", func() { PSD(signal, 10, 0, nil, -1) }},
+		{"Короткий сигнал", func() { PSD(signal, 200, 0, nil, 1) }},
+		{"Неверная длина окна", func() { PSD(signal, 10, 0, make([]float64, 5), 1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Ожидалась паника")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
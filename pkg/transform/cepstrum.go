@@ -0,0 +1,52 @@
+package transform
+
+import (
+	"math"
+	"math/cmplx"
+
+	"dsp_go/pkg/fft"
+)
+
+// cepstrumFloor - нижняя граница модуля спектра относительно его максимума.
+// Ограничивает логарифм нулевых бинов (-Inf) уровнем -200 дБ
+const cepstrumFloor = 1e-10
+
+// RealCepstrum вычисляет вещественный кепстр сигнала IFFT(log|FFT(x)|).
+// Периодический сигнал с периодом P отсчетов дает пик на кепстральной
+// задержке (quefrency) P, по которому оценивается основная частота fs/P;
+// эхо с задержкой D - пик на задержке D. Модули бинов ограничиваются снизу
+// уровнем cepstrumFloor от максимума, чтобы нулевые бины не давали -Inf.
+// Длина результата совпадает с длиной сигнала; значимы задержки до len(x)/2
+func RealCepstrum(signal []float64) []float64 {
+	n := len(signal)
+	if n == 0 {
+		return []float64{}
+	}
+
+	frame := make([]complex128, n)
+	for i, v := range signal {
+		frame[i] = complex(v, 0)
+	}
+	spectrum := fft.FFTAny(frame)
+
+	var peak float64
+	for _, s := range spectrum {
+		peak = max(peak, cmplx.Abs(s))
+	}
+	if peak == 0 {
+		return make([]float64, n) // Нулевой сигнал: логарифм не определен
+	}
+	floor := peak * cepstrumFloor
+
+	for k, s := range spectrum {
+		spectrum[k] = complex(math.Log(max(cmplx.Abs(s), floor)), 0)
+	}
+
+	// Логарифм модуля - четная вещественная функция, поэтому кепстр вещественный
+	cepstrum := fft.IFFTAny(spectrum)
+	result := make([]float64, n)
+	for i, c := range cepstrum {
+		result[i] = real(c)
+	}
+	return result
+}
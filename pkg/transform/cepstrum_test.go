@@ -0,0 +1,68 @@
+package transform
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"dsp_go/pkg/generators"
+)
+
+// TestRealCepstrum_HarmonicPitch проверяет положение кепстрального пика
+// для сигнала с богатым гармоническим составом
+func TestRealCepstrum_HarmonicPitch(t *testing.T) {
+	const (
+		fs     = 8000.0
+		period = 40 // Период основного тона в отсчетах (200 Гц)
+	)
+
+	components := make([]generators.ToneComponent, 15)
+	for k := range components {
+		components[k] = generators.ToneComponent{
+			Frequency: float64(k+1) * fs / period,
+			Amplitude: 1 / float64(k+1),
+			Phase:     0.3 * float64(k),
+		}
+	}
+	signal, err := generators.NewMultiTone(fs, 0.128, components...).Generate()
+	if err != nil {
+		t.Fatalf("Ошибка генерации: %v", err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := range signal {
+		signal[i] += 0.05 * rng.NormFloat64()
+	}
+
+	cepstrum := RealCepstrum(signal)
+	if len(cepstrum) != len(signal) {
+		t.Fatalf("Длина кепстра: ожидалось %d, получено %d", len(signal), len(cepstrum))
+	}
+
+	// Пик ищется за пределами области малых задержек (огибающая спектра)
+	peak := 20
+	for q := 20; q < len(cepstrum)/2; q++ {
+		if cepstrum[q] > cepstrum[peak] {
+			peak = q
+		}
+	}
+	if peak != period {
+		t.Errorf("Кепстральный пик на задержке %d, ожидалось %d", peak, period)
+	}
+	if f0 := fs / float64(peak); math.Abs(f0-200) > 1e-9 {
+		t.Errorf("Основная частота: ожидалось 200 Гц, получено %.1f Гц", f0)
+	}
+}
+
+// TestRealCepstrum_Degenerate проверяет пустой и нулевой сигналы
+func TestRealCepstrum_Degenerate(t *testing.T) {
+	if got := RealCepstrum(nil); len(got) != 0 {
+		t.Errorf("Пустой сигнал: ожидался пустой результат, получено %d отсчетов", len(got))
+	}
+
+	for i, v := range RealCepstrum(make([]float64, 16)) {
+		if v != 0 {
+			t.Fatalf("Нулевой сигнал, отсчет %d: ожидалось 0, получено %g", i, v)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Package transform реализует преобразования сигналов
+package transform
+
+import "dsp_go/pkg/fft"
+
+// Hilbert вычисляет аналитический сигнал x + j·H{x}, где H{x} - преобразование
+// Гильберта. Спектр сигнала обнуляется на отрицательных частотах и удваивается
+// на положительных (нулевой бин и бин Найквиста не изменяются), после чего
+// выполняется обратное БПФ. Вещественная часть результата совпадает с x,
+// модуль - огибающая сигнала, аргумент - мгновенная фаза.
+//
+// Преобразование выполняется над всем блоком, поэтому на краях непериодических
+// сигналов возможны искажения
+func Hilbert(x []float64) []complex128 {
+	n := len(x)
+	if n == 0 {
+		return []complex128{}
+	}
+
+	signal := make([]complex128, n)
+	for i, v := range x {
+		signal[i] = complex(v, 0)
+	}
+	spectrum := fft.FFTAny(signal)
+
+	// Положительные частоты: бины 1..(n-1)/2; при четном n бин n/2 - Найквист
+	for k := 1; k < (n+1)/2; k++ {
+		spectrum[k] *= 2
+	}
+	for k := n/2 + 1; k < n; k++ {
+		spectrum[k] = 0
+	}
+
+	return fft.IFFTAny(spectrum)
+}
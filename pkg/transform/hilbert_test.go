@@ -0,0 +1,60 @@
+package transform
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// TestHilbert_Cosine проверяет, что мнимая часть аналитического сигнала cos(ωn) равна sin(ωn)
+func TestHilbert_Cosine(t *testing.T) {
+	// Целое число периодов в блоке исключает краевые эффекты
+	for _, n := range []int{256, 1000, 999} {
+		w := 2 * math.Pi * 10 / float64(n)
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = math.Cos(w * float64(i))
+		}
+
+		analytic := Hilbert(x)
+		if len(analytic) != n {
+			t.Fatalf("N=%d: ожидалась длина %d, получено %d", n, n, len(analytic))
+		}
+		for i, z := range analytic {
+			if math.Abs(real(z)-x[i]) > 1e-9 {
+				t.Fatalf("N=%d, отсчет %d: вещественная часть %f, ожидалось %f", n, i, real(z), x[i])
+			}
+			if want := math.Sin(w * float64(i)); math.Abs(imag(z)-want) > 1e-9 {
+				t.Fatalf("N=%d, отсчет %d: мнимая часть %f, ожидалось %f", n, i, imag(z), want)
+			}
+		}
+	}
+}
+
+// TestHilbert_Envelope проверяет выделение огибающей амплитудно-модулированного сигнала
+func TestHilbert_Envelope(t *testing.T) {
+	const n = 2048
+	carrier := 2 * math.Pi * 200 / n
+	modulation := 2 * math.Pi * 4 / n
+
+	x := make([]float64, n)
+	envelope := make([]float64, n)
+	for i := range x {
+		envelope[i] = 1 + 0.5*math.Cos(modulation*float64(i))
+		x[i] = envelope[i] * math.Cos(carrier*float64(i))
+	}
+
+	analytic := Hilbert(x)
+	for i, z := range analytic {
+		if math.Abs(cmplx.Abs(z)-envelope[i]) > 1e-9 {
+			t.Fatalf("Отсчет %d: огибающая %f, ожидалось %f", i, cmplx.Abs(z), envelope[i])
+		}
+	}
+}
+
+// TestHilbert_Empty проверяет обработку пустого входа
+func TestHilbert_Empty(t *testing.T) {
+	if got := Hilbert(nil); got == nil || len(got) != 0 {
+		t.Errorf("Ожидался пустой срез, получено %v", got)
+	}
+}
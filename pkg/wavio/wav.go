@@ -0,0 +1,185 @@
+// Package wavio реализует чтение и запись моно WAV-файлов с линейной ИКМ
+package wavio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ErrUnsupportedFormat возвращается для WAV-файлов, которые не являются
+// моно линейной ИКМ с разрядностью 16 или 24 бита
+var ErrUnsupportedFormat = errors.New("wavio: unsupported WAV format")
+
+const (
+	formatPCM        = 1      // Линейная ИКМ
+	formatExtensible = 0xFFFE // WAVE_FORMAT_EXTENSIBLE (подформат в расширении)
+
+	// maxFmtChunk - число читаемых байтов fmt-фрагмента, остальное пропускается
+	// (WAVE_FORMAT_EXTENSIBLE занимает 40 байт)
+	maxFmtChunk = 64
+	// streamingDataSize - размер data-фрагмента, записываемый при потоковой
+	// записи, когда длина заранее неизвестна: данные читаются до конца файла
+	streamingDataSize = 0xFFFFFFFF
+)
+
+// ReadWAV читает моно WAV-файл с линейной ИКМ 16 или 24 бита.
+// Отсчеты нормируются в диапазон [-1, 1), частота дискретизации берется из заголовка.
+// Многоканальные файлы и другие форматы возвращают ошибку, оборачивающую ErrUnsupportedFormat.
+// Размер data-фрагмента 0xFFFFFFFF (потоковая запись) означает чтение до конца файла
+func ReadWAV(r io.Reader) (samples []float64, sampleRate float64, err error) {
+	var riff [12]byte
+	if _, err := io.ReadFull(r, riff[:]); err != nil {
+		return nil, 0, fmt.Errorf("wavio: reading RIFF header: %w", err)
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return nil, 0, errors.New("wavio: not a RIFF/WAVE file")
+	}
+
+	var (
+		haveFmt  bool
+		channels uint16
+		bits     uint16
+	)
+
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, 0, fmt.Errorf("wavio: data chunk not found: %w", err)
+		}
+		id := string(header[0:4])
+		// Размер в int64: с байтом выравнивания он может превысить uint32
+		size := int64(binary.LittleEndian.Uint32(header[4:8]))
+		padded := size + size%2
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, 0, fmt.Errorf("wavio: fmt chunk too short: %d bytes", size)
+			}
+			chunk := make([]byte, min(size, maxFmtChunk))
+			if _, err := io.ReadFull(r, chunk); err != nil {
+				return nil, 0, fmt.Errorf("wavio: reading fmt chunk: %w", err)
+			}
+			// Пропускаем лишние байты расширения и байт выравнивания
+			if _, err := io.CopyN(io.Discard, r, padded-int64(len(chunk))); err != nil {
+				return nil, 0, fmt.Errorf("wavio: skipping fmt chunk tail: %w", err)
+			}
+
+			format := binary.LittleEndian.Uint16(chunk[0:2])
+			channels = binary.LittleEndian.Uint16(chunk[2:4])
+			sampleRate = float64(binary.LittleEndian.Uint32(chunk[4:8]))
+			bits = binary.LittleEndian.Uint16(chunk[14:16])
+
+			// В WAVE_FORMAT_EXTENSIBLE код формата хранится в первых байтах GUID подформата
+			if format == formatExtensible && len(chunk) >= 26 {
+				format = binary.LittleEndian.Uint16(chunk[24:26])
+			}
+			if format != formatPCM {
+				return nil, 0, fmt.Errorf("%w: format code %d, only PCM is supported", ErrUnsupportedFormat, format)
+			}
+			if channels != 1 {
+				return nil, 0, fmt.Errorf("%w: %d channels, only mono is supported", ErrUnsupportedFormat, channels)
+			}
+			if bits != 16 && bits != 24 {
+				return nil, 0, fmt.Errorf("%w: %d bits per sample, only 16 and 24 are supported", ErrUnsupportedFormat, bits)
+			}
+			haveFmt = true
+
+		case "data":
+			if !haveFmt {
+				return nil, 0, errors.New("wavio: data chunk before fmt chunk")
+			}
+			// Буфер не выделяется по размеру из заголовка: он может быть ложным
+			data, err := io.ReadAll(io.LimitReader(r, size))
+			if err != nil {
+				return nil, 0, fmt.Errorf("wavio: reading data chunk: %w", err)
+			}
+			if int64(len(data)) < size && size != streamingDataSize {
+				return nil, 0, fmt.Errorf("wavio: reading data chunk: %w", io.ErrUnexpectedEOF)
+			}
+			return decodePCM(data, int(bits)), sampleRate, nil
+
+		default:
+			// Пропускаем неизвестные фрагменты (с байтом выравнивания для нечетного размера)
+			if _, err := io.CopyN(io.Discard, r, padded); err != nil {
+				return nil, 0, fmt.Errorf("wavio: skipping %q chunk: %w", id, err)
+			}
+		}
+	}
+}
+
+// decodePCM преобразует байты ИКМ в нормированные отсчеты
+func decodePCM(data []byte, bits int) []float64 {
+	bytesPerSample := bits / 8
+	samples := make([]float64, len(data)/bytesPerSample)
+
+	for i := range samples {
+		b := data[i*bytesPerSample:]
+		switch bits {
+		case 16:
+			samples[i] = float64(int16(binary.LittleEndian.Uint16(b))) / (1 << 15)
+		case 24:
+			// Сдвиг влево и арифметический сдвиг вправо расширяют знак
+			v := int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24) >> 8
+			samples[i] = float64(v) / (1 << 23)
+		}
+	}
+	return samples
+}
+
+// WriteWAV записывает отсчеты в моно WAV-файл с линейной ИКМ 16 или 24 бита.
+// Отсчеты ожидаются в диапазоне [-1, 1]; значения вне диапазона ограничиваются
+func WriteWAV(w io.Writer, samples []float64, sampleRate float64, bitDepth int) error {
+	if bitDepth != 16 && bitDepth != 24 {
+		return fmt.Errorf("%w: %d bits per sample, only 16 and 24 are supported", ErrUnsupportedFormat, bitDepth)
+	}
+	if sampleRate <= 0 || sampleRate > math.MaxUint32 {
+		return fmt.Errorf("wavio: invalid sample rate %f", sampleRate)
+	}
+
+	bytesPerSample := bitDepth / 8
+	dataSize := len(samples) * bytesPerSample
+	rate := uint32(math.Round(sampleRate))
+
+	header := make([]byte, 44)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(36+dataSize+dataSize%2))
+	copy(header[8:12], "WAVE")
+	copy(header[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:20], 16)
+	binary.LittleEndian.PutUint16(header[20:22], formatPCM)
+	binary.LittleEndian.PutUint16(header[22:24], 1)
+	binary.LittleEndian.PutUint32(header[24:28], rate)
+	binary.LittleEndian.PutUint32(header[28:32], rate*uint32(bytesPerSample))
+	binary.LittleEndian.PutUint16(header[32:34], uint16(bytesPerSample))
+	binary.LittleEndian.PutUint16(header[34:36], uint16(bitDepth))
+	copy(header[36:40], "data")
+	binary.LittleEndian.PutUint32(header[40:44], uint32(dataSize))
+
+	if _, err := w.Write(header); err != nil {
+		return fmt.Errorf("wavio: writing header: %w", err)
+	}
+
+	data := make([]byte, dataSize+dataSize%2)
+	scale := float64(int64(1) << (bitDepth - 1))
+	maxValue := scale - 1
+
+	for i, s := range samples {
+		v := int32(math.Max(-scale, math.Min(maxValue, math.Round(s*scale))))
+		b := data[i*bytesPerSample:]
+		switch bitDepth {
+		case 16:
+			binary.LittleEndian.PutUint16(b, uint16(int16(v)))
+		case 24:
+			b[0], b[1], b[2] = byte(v), byte(v>>8), byte(v>>16)
+		}
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("wavio: writing samples: %w", err)
+	}
+	return nil
+}
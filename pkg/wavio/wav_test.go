@@ -0,0 +1,131 @@
+package wavio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestWAV_RoundTrip проверяет, что запись и чтение сохраняют отсчеты
+// с точностью до шага квантования
+func TestWAV_RoundTrip(t *testing.T) {
+	samples := make([]float64, 1001) // Нечетный размер данных для 24 бит
+	for i := range samples {
+		samples[i] = 0.9 * math.Sin(2*math.Pi*440*float64(i)/44100)
+	}
+	samples[0], samples[1] = 1.0, -1.0 // Граничные значения
+
+	for _, bits := range []int{16, 24} {
+		var buf bytes.Buffer
+		if err := WriteWAV(&buf, samples, 44100, bits); err != nil {
+			t.Fatalf("%d бит: ошибка записи: %v", bits, err)
+		}
+
+		got, rate, err := ReadWAV(&buf)
+		if err != nil {
+			t.Fatalf("%d бит: ошибка чтения: %v", bits, err)
+		}
+		if rate != 44100 {
+			t.Errorf("%d бит: частота дискретизации %f, ожидалось 44100", bits, rate)
+		}
+		if len(got) != len(samples) {
+			t.Fatalf("%d бит: ожидалось %d отсчетов, получено %d", bits, len(samples), len(got))
+		}
+
+		step := 1 / math.Pow(2, float64(bits-1))
+		for i := range samples {
+			if math.Abs(got[i]-samples[i]) > step {
+				t.Fatalf("%d бит, отсчет %d: ожидалось %f, получено %f", bits, i, samples[i], got[i])
+			}
+		}
+	}
+}
+
+// TestReadWAV_SkipsUnknownChunks проверяет пропуск посторонних фрагментов
+func TestReadWAV_SkipsUnknownChunks(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteWAV(&buf, []float64{0.5, -0.25}, 8000, 16); err != nil {
+		t.Fatalf("Ошибка записи: %v", err)
+	}
+	raw := buf.Bytes()
+
+	// Вставляем фрагмент "LIST" нечетного размера между fmt и data
+	extra := append([]byte("LIST"), 3, 0, 0, 0, 'a', 'b', 'c', 0)
+	withList := append(append(append([]byte{}, raw[:36]...), extra...), raw[36:]...)
+
+	got, _, err := ReadWAV(bytes.NewReader(withList))
+	if err != nil {
+		t.Fatalf("Ошибка чтения: %v", err)
+	}
+	if len(got) != 2 || got[0] != 0.5 || got[1] != -0.25 {
+		t.Errorf("Ожидалось [0.5 -0.25], получено %v", got)
+	}
+}
+
+// TestReadWAV_Errors проверяет ошибки для неподдерживаемых файлов
+func TestReadWAV_Errors(t *testing.T) {
+	var valid bytes.Buffer
+	if err := WriteWAV(&valid, []float64{0, 0.1}, 8000, 16); err != nil {
+		t.Fatalf("Ошибка записи: %v", err)
+	}
+
+	stereo := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint16(stereo[22:24], 2)
+
+	float32WAV := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint16(float32WAV[20:22], 3)
+
+	bits8 := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint16(bits8[34:36], 8)
+
+	// Размер fmt-фрагмента 0xFFFFFFFF: с байтом выравнивания переполняет uint32
+	hugeFmt := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint32(hugeFmt[16:20], 0xFFFFFFFF)
+
+	// Заголовок обещает почти 4 ГиБ данных, в файле 4 байта
+	lyingData := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint32(lyingData[40:44], 0xFFFFFFF0)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		unsupported bool
+	}{
+		{"Стерео", stereo, true},
+		{"Плавающая точка", float32WAV, true},
+		{"8 бит", bits8, true},
+		{"Не RIFF", []byte("RIFX0000WAVEfmt "), false},
+		{"Усеченный файл", valid.Bytes()[:40], false},
+		{"Размер fmt 0xFFFFFFFF", hugeFmt, false},
+		{"Ложный размер data", lyingData, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ReadWAV(bytes.NewReader(tt.data))
+			if err == nil {
+				t.Fatal("Ожидалась ошибка")
+			}
+			if tt.unsupported != errors.Is(err, ErrUnsupportedFormat) {
+				t.Errorf("errors.Is(err, ErrUnsupportedFormat) = %v, ошибка: %v", !tt.unsupported, err)
+			}
+		})
+	}
+
+	// Потоковый размер data 0xFFFFFFFF: отсчеты читаются до конца файла
+	streaming := append([]byte{}, valid.Bytes()...)
+	binary.LittleEndian.PutUint32(streaming[40:44], 0xFFFFFFFF)
+	samples, _, err := ReadWAV(bytes.NewReader(streaming))
+	if err != nil {
+		t.Fatalf("Потоковый размер data: ошибка %v", err)
+	}
+	if len(samples) != 2 {
+		t.Errorf("Потоковый размер data: ожидалось 2 отсчета, получено %d", len(samples))
+	}
+
+	if err := WriteWAV(&bytes.Buffer{}, nil, 8000, 8); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("WriteWAV с 8 битами: ожидалась ErrUnsupportedFormat, получено %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package windows
+
+import "math"
+
+// BartlettWindow генерирует коэффициенты треугольного окна Бартлетта,
+// равного нулю на обоих краях и 1 в центре (при нечетном N)
+func BartlettWindow(N int) []float64 {
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	for n := 0; n < N; n++ {
+		x := 2 * float64(n) / float64(N-1)
+		window[n] = 1 - math.Abs(x-1)
+	}
+	return window
+}
+
+// ApplyBartlettWindow применяет окно Бартлетта к исходным коэффициентам
+func ApplyBartlettWindow(coeffs []float64) []float64 {
+	N := len(coeffs)
+	window := BartlettWindow(N)
+
+	modifiedCoeffs := make([]float64, N)
+	for i := 0; i < N; i++ {
+		modifiedCoeffs[i] = coeffs[i] * window[i]
+	}
+	return modifiedCoeffs
+}
+
+// BartlettHannWindow генерирует коэффициенты окна Бартлетта-Ханна -
+// комбинации треугольного окна и окна Ханна с меньшими дальними
+// боковыми лепестками, чем у окна Бартлетта
+func BartlettHannWindow(N int) []float64 {
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	a0, a1, a2 := 0.62, 0.48, 0.38
+	for n := 0; n < N; n++ {
+		x := float64(n) / float64(N-1)
+		window[n] = a0 -
+			a1*math.Abs(x-0.5) -
+			a2*math.Cos(2*math.Pi*x)
+	}
+	return window
+}
+
+// ApplyBartlettHannWindow применяет окно Бартлетта-Ханна к исходным коэффициентам
+func ApplyBartlettHannWindow(coeffs []float64) []float64 {
+	N := len(coeffs)
+	window := BartlettHannWindow(N)
+
+	modifiedCoeffs := make([]float64, N)
+	for i := 0; i < N; i++ {
+		modifiedCoeffs[i] = coeffs[i] * window[i]
+	}
+	return modifiedCoeffs
+}
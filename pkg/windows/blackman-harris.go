@@ -5,6 +5,11 @@ import "math"
 // blackmanHarrisWindow генерирует коэффициенты окна Блэкмана-Харриса
 func blackmanHarrisWindow(N int) []float64 {
 	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
 	a0, a1, a2, a3 := 0.35875, 0.48829, 0.14128, 0.01168
 
 	for n := 0; n < N; n++ {
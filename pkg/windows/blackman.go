@@ -0,0 +1,21 @@
+package windows
+
+import "math"
+
+// blackmanWindow генерирует коэффициенты окна Блэкмана
+func blackmanWindow(N int) []float64 {
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	a0, a1, a2 := 0.42, 0.5, 0.08
+	for n := 0; n < N; n++ {
+		x := math.Pi * 2 * float64(n) / float64(N-1)
+		window[n] = a0 -
+			a1*math.Cos(x) +
+			a2*math.Cos(2*x)
+	}
+	return window
+}
@@ -0,0 +1,30 @@
+package windows
+
+import "math"
+
+// HammingWindow генерирует коэффициенты окна Хэмминга
+func HammingWindow(N int) []float64 {
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	for n := 0; n < N; n++ {
+		x := math.Pi * 2 * float64(n) / float64(N-1)
+		window[n] = 0.54 - 0.46*math.Cos(x)
+	}
+	return window
+}
+
+// ApplyHammingWindow применяет окно Хэмминга к исходным коэффициентам
+func ApplyHammingWindow(coeffs []float64) []float64 {
+	N := len(coeffs)
+	window := HammingWindow(N)
+
+	modifiedCoeffs := make([]float64, N)
+	for i := 0; i < N; i++ {
+		modifiedCoeffs[i] = coeffs[i] * window[i]
+	}
+	return modifiedCoeffs
+}
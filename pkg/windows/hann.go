@@ -0,0 +1,30 @@
+package windows
+
+import "math"
+
+// HannWindow генерирует коэффициенты окна Ханна
+func HannWindow(N int) []float64 {
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	for n := 0; n < N; n++ {
+		x := math.Pi * 2 * float64(n) / float64(N-1)
+		window[n] = 0.5 - 0.5*math.Cos(x)
+	}
+	return window
+}
+
+// ApplyHannWindow применяет окно Ханна к исходным коэффициентам
+func ApplyHannWindow(coeffs []float64) []float64 {
+	N := len(coeffs)
+	window := HannWindow(N)
+
+	modifiedCoeffs := make([]float64, N)
+	for i := 0; i < N; i++ {
+		modifiedCoeffs[i] = coeffs[i] * window[i]
+	}
+	return modifiedCoeffs
+}
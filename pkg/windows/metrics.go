@@ -0,0 +1,30 @@
+package windows
+
+// CoherentGain возвращает когерентное усиление окна (среднее значение коэффициентов).
+// Амплитуду гармоники в спектре взвешенного сигнала следует делить на эту величину
+func CoherentGain(window []float64) float64 {
+	if len(window) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, w := range window {
+		sum += w
+	}
+	return sum / float64(len(window))
+}
+
+// EquivalentNoiseBandwidth возвращает эквивалентную шумовую полосу окна в бинах:
+// ENBW = N * sum(w^2) / sum(w)^2
+func EquivalentNoiseBandwidth(window []float64) float64 {
+	var sum, sumSq float64
+	for _, w := range window {
+		sum += w
+		sumSq += w * w
+	}
+
+	if sum == 0 {
+		return 0 // Избегаем деления на ноль
+	}
+	return float64(len(window)) * sumSq / (sum * sum)
+}
@@ -0,0 +1,10 @@
+package windows
+
+// rectangularWindow генерирует коэффициенты прямоугольного окна (все равны 1)
+func rectangularWindow(N int) []float64 {
+	window := make([]float64, N)
+	for n := range window {
+		window[n] = 1.0
+	}
+	return window
+}
@@ -0,0 +1,45 @@
+package windows
+
+import "math"
+
+// TukeyWindow генерирует коэффициенты окна Тьюки (косинусоидально сглаженного
+// прямоугольного окна). Доля alpha длины окна занята косинусными спадами
+// (по alpha/2 с каждого края), центральная часть (1-alpha) равна 1.
+// alpha = 0 дает прямоугольное окно, alpha = 1 - окно Ханна
+// alpha: доля спадов (0 <= alpha <= 1)
+func TukeyWindow(N int, alpha float64) []float64 {
+	if alpha < 0 || alpha > 1 || math.IsNaN(alpha) {
+		panic("windows: Tukey alpha must be between 0 and 1")
+	}
+
+	window := make([]float64, N)
+	if N == 1 {
+		window[0] = 1.0
+		return window
+	}
+
+	for n := 0; n < N; n++ {
+		x := float64(n) / float64(N-1)
+		switch {
+		case x < alpha/2:
+			window[n] = 0.5 - 0.5*math.Cos(2*math.Pi*x/alpha)
+		case x > 1-alpha/2:
+			window[n] = 0.5 - 0.5*math.Cos(2*math.Pi*(1-x)/alpha)
+		default:
+			window[n] = 1.0
+		}
+	}
+	return window
+}
+
+// ApplyTukeyWindow применяет окно Тьюки к исходным коэффициентам
+func ApplyTukeyWindow(coeffs []float64, alpha float64) []float64 {
+	N := len(coeffs)
+	window := TukeyWindow(N, alpha)
+
+	modifiedCoeffs := make([]float64, N)
+	for i := 0; i < N; i++ {
+		modifiedCoeffs[i] = coeffs[i] * window[i]
+	}
+	return modifiedCoeffs
+}
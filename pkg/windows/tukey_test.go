@@ -0,0 +1,82 @@
+package windows
+
+import (
+	"math"
+	"testing"
+)
+
+// TestTukeyWindow_Limits проверяет предельные случаи alpha = 0 и alpha = 1
+func TestTukeyWindow_Limits(t *testing.T) {
+	for _, N := range []int{1, 2, 64, 65} {
+		for n, w := range TukeyWindow(N, 0) {
+			if w != 1 {
+				t.Errorf("N=%d, alpha=0, позиция %d: ожидалось 1, получено %f", N, n, w)
+			}
+		}
+
+		hann := HannWindow(N)
+		for n, w := range TukeyWindow(N, 1) {
+			if math.Abs(w-hann[n]) > 1e-12 {
+				t.Errorf("N=%d, alpha=1, позиция %d: ожидалось %f (Ханн), получено %f", N, n, hann[n], w)
+			}
+		}
+	}
+}
+
+// TestTukeyWindow_FlatCenter проверяет плоскую центральную часть и симметрию
+func TestTukeyWindow_FlatCenter(t *testing.T) {
+	const N = 101
+	for _, alpha := range []float64{0.1, 0.25, 0.5, 0.8} {
+		w := TukeyWindow(N, alpha)
+
+		// Центральная доля (1-alpha) отсчетов равна 1
+		flat := 0
+		for n := range w {
+			x := float64(n) / (N - 1)
+			if x >= alpha/2 && x <= 1-alpha/2 {
+				if w[n] != 1 {
+					t.Errorf("alpha=%.2f, позиция %d: ожидалось 1, получено %f", alpha, n, w[n])
+				}
+				flat++
+			} else if w[n] >= 1 {
+				t.Errorf("alpha=%.2f, позиция %d: спад должен быть меньше 1, получено %f", alpha, n, w[n])
+			}
+		}
+		if want := (1 - alpha) * (N - 1); math.Abs(float64(flat)-want) > 2 {
+			t.Errorf("alpha=%.2f: плоских отсчетов %d, ожидалось около %.0f", alpha, flat, want)
+		}
+
+		if w[0] != 0 || w[N-1] != 0 {
+			t.Errorf("alpha=%.2f: края окна %f и %f, ожидалось 0", alpha, w[0], w[N-1])
+		}
+		for n := 0; n < N/2; n++ {
+			if math.Abs(w[n]-w[N-1-n]) > 1e-12 {
+				t.Errorf("alpha=%.2f: нарушена симметрия в позиции %d", alpha, n)
+				break
+			}
+		}
+	}
+}
+
+// TestApplyTukeyWindow проверяет применение окна и панику при неверном alpha
+func TestApplyTukeyWindow(t *testing.T) {
+	coeffs := []float64{2, 2, 2, 2, 2, 2, 2}
+	result := ApplyTukeyWindow(coeffs, 0.5)
+	w := TukeyWindow(len(coeffs), 0.5)
+	for i := range result {
+		if math.Abs(result[i]-2*w[i]) > 1e-12 {
+			t.Errorf("Позиция %d: ожидалось %f, получено %f", i, 2*w[i], result[i])
+		}
+	}
+
+	for _, alpha := range []float64{-0.1, 1.5, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("alpha=%.2f: ожидалась паника", alpha)
+				}
+			}()
+			TukeyWindow(16, alpha)
+		}()
+	}
+}
@@ -0,0 +1,124 @@
+package windows
+
+// WindowFunc генерирует N коэффициентов оконной функции
+type WindowFunc func(N int) []float64
+
+// Window определяет тип оконной функции
+type Window int
+
+const (
+	Rectangular    Window = iota // Прямоугольное окно
+	Hann                         // Окно Ханна
+	Hamming                      // Окно Хэмминга
+	Blackman                     // Окно Блэкмана
+	BlackmanHarris               // Окно Блэкмана-Харриса
+	Bartlett                     // Треугольное окно Бартлетта
+	BartlettHann                 // Окно Бартлетта-Ханна
+)
+
+// String возвращает строковое представление типа окна
+func (w Window) String() string {
+	switch w {
+	case Rectangular:
+		return "Прямоугольное"
+	case Hann:
+		return "Ханна"
+	case Hamming:
+		return "Хэмминга"
+	case Blackman:
+		return "Блэкмана"
+	case BlackmanHarris:
+		return "Блэкмана-Харриса"
+	case Bartlett:
+		return "Бартлетта"
+	case BartlettHann:
+		return "Бартлетта-Ханна"
+	default:
+		return "Неизвестное"
+	}
+}
+
+// registry сопоставляет тип окна с функцией генерации коэффициентов
+var registry = map[Window]WindowFunc{
+	Rectangular:    rectangularWindow,
+	Hann:           HannWindow,
+	Hamming:        HammingWindow,
+	Blackman:       blackmanWindow,
+	BlackmanHarris: blackmanHarrisWindow,
+	Bartlett:       BartlettWindow,
+	BartlettHann:   BartlettHannWindow,
+}
+
+// Get возвращает функцию генерации коэффициентов для заданного типа окна
+func Get(name Window) WindowFunc {
+	fn, ok := registry[name]
+	if !ok {
+		panic("windows: unknown window type")
+	}
+	return fn
+}
+
+// Mode определяет вариант формулы оконной функции
+type Mode int
+
+const (
+	// Symmetric - симметричное окно (знаменатель N-1), w[n] = w[N-1-n].
+	// Используется при проектировании КИХ-фильтров; вариант по умолчанию
+	Symmetric Mode = iota
+	// Periodic - периодическое (ДПФ-четное) окно (знаменатель N), w[n] = w[N-n].
+	// Период окна совпадает с длиной ДПФ, что повышает точность амплитуд
+	// при спектральном анализе
+	Periodic
+)
+
+// String возвращает строковое представление варианта окна
+func (m Mode) String() string {
+	switch m {
+	case Symmetric:
+		return "Симметричное"
+	case Periodic:
+		return "Периодическое"
+	default:
+		return "Неизвестное"
+	}
+}
+
+// GetWithMode возвращает функцию генерации коэффициентов для заданного типа
+// и варианта окна. GetWithMode(name, Symmetric) эквивалентно Get(name)
+func GetWithMode(name Window, mode Mode) WindowFunc {
+	fn := Get(name)
+	switch mode {
+	case Symmetric:
+		return fn
+	case Periodic:
+		return PeriodicOf(fn)
+	default:
+		panic("windows: unknown window mode")
+	}
+}
+
+// PeriodicOf преобразует генератор симметричного окна в генератор
+// периодического: периодическое окно длины N совпадает с первыми N
+// отсчетами симметричного окна длины N+1. Окно из одного отсчета
+// остается равным [1]
+func PeriodicOf(fn WindowFunc) WindowFunc {
+	return func(N int) []float64 {
+		if N <= 1 {
+			return fn(N)
+		}
+		return fn(N + 1)[:N]
+	}
+}
+
+// ApplyWindowComplex применяет вещественное окно к комплексному (IQ) сигналу
+func ApplyWindowComplex(signal []complex128, window []float64) []complex128 {
+	if len(signal) != len(window) {
+		panic("windows: signal and window lengths must match")
+	}
+
+	result := make([]complex128, len(signal))
+	for i, s := range signal {
+		result[i] = s * complex(window[i], 0)
+	}
+	return result
+}
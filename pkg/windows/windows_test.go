@@ -0,0 +1,225 @@
+package windows
+
+import (
+	"math"
+	"testing"
+)
+
+// TestCosineWindows проверяет форму окон Ханна, Хэмминга и Блэкмана-Харриса
+func TestCosineWindows(t *testing.T) {
+	tests := []struct {
+		name   string
+		window WindowFunc
+		edge   float64 // Значение на краях окна
+	}{
+		{name: "Hann", window: HannWindow, edge: 0.0},
+		{name: "Hamming", window: HammingWindow, edge: 0.08},
+		{name: "BlackmanHarris", window: blackmanHarrisWindow, edge: 0.00006},
+		{name: "Bartlett", window: BartlettWindow, edge: 0.0},
+		{name: "BartlettHann", window: BartlettHannWindow, edge: 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			N := 65
+			w := tt.window(N)
+
+			if len(w) != N {
+				t.Fatalf("Ожидалась длина %d, получено %d", N, len(w))
+			}
+			if math.Abs(w[0]-tt.edge) > 1e-10 || math.Abs(w[N-1]-tt.edge) > 1e-10 {
+				t.Errorf("Края окна: ожидалось %f, получено %f и %f", tt.edge, w[0], w[N-1])
+			}
+			if math.Abs(w[N/2]-1.0) > 1e-10 {
+				t.Errorf("Центр окна: ожидалось 1, получено %f", w[N/2])
+			}
+			for i := 0; i < N/2; i++ {
+				if math.Abs(w[i]-w[N-1-i]) > 1e-12 {
+					t.Errorf("Нарушена симметрия в позиции %d", i)
+				}
+			}
+
+			// Окно из одного отсчета не должно делить на ноль
+			single := tt.window(1)
+			if len(single) != 1 || single[0] != 1.0 {
+				t.Errorf("Окно из одного отсчета: ожидалось [1], получено %v", single)
+			}
+		})
+	}
+}
+
+// TestApplyWindow проверяет поэлементное умножение коэффициентов на окно
+func TestApplyWindow(t *testing.T) {
+	coeffs := []float64{2, 2, 2, 2, 2}
+
+	tests := []struct {
+		name  string
+		apply func([]float64) []float64
+		gen   WindowFunc
+	}{
+		{name: "Hann", apply: ApplyHannWindow, gen: HannWindow},
+		{name: "Hamming", apply: ApplyHammingWindow, gen: HammingWindow},
+		{name: "BlackmanHarris", apply: ApplyBlackmanHarrisWindow, gen: blackmanHarrisWindow},
+		{name: "Bartlett", apply: ApplyBartlettWindow, gen: BartlettWindow},
+		{name: "BartlettHann", apply: ApplyBartlettHannWindow, gen: BartlettHannWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.apply(coeffs)
+			w := tt.gen(len(coeffs))
+			for i := range result {
+				if math.Abs(result[i]-2*w[i]) > 1e-12 {
+					t.Errorf("Позиция %d: ожидалось %f, получено %f", i, 2*w[i], result[i])
+				}
+			}
+
+			// Исходные коэффициенты не изменяются
+			for i, c := range coeffs {
+				if c != 2 {
+					t.Errorf("Исходный коэффициент %d изменен: %f", i, c)
+				}
+			}
+		})
+	}
+}
+
+// TestApplyWindowComplex проверяет применение окна к комплексному сигналу
+func TestApplyWindowComplex(t *testing.T) {
+	N := 16
+	window := HannWindow(N)
+
+	signal := make([]complex128, N)
+	for i := range signal {
+		signal[i] = complex(3, -2)
+	}
+
+	result := ApplyWindowComplex(signal, window)
+	for i, val := range result {
+		if math.Abs(real(val)-3*window[i]) > 1e-12 {
+			t.Errorf("Позиция %d: вещественная часть %f, ожидалось %f", i, real(val), 3*window[i])
+		}
+		if math.Abs(imag(val)+2*window[i]) > 1e-12 {
+			t.Errorf("Позиция %d: мнимая часть %f, ожидалось %f", i, imag(val), -2*window[i])
+		}
+	}
+
+	// Исходный сигнал не изменяется
+	if signal[N/2] != complex(3, -2) {
+		t.Errorf("Исходный сигнал изменен: %v", signal[N/2])
+	}
+}
+
+// TestApplyWindowComplexLengthMismatch проверяет панику при разных длинах
+func TestApplyWindowComplexLengthMismatch(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Ожидалась паника при несовпадении длин")
+		}
+	}()
+
+	ApplyWindowComplex(make([]complex128, 4), HannWindow(5))
+}
+
+// TestWindowGainMetrics проверяет когерентное усиление и шумовую полосу окон
+func TestWindowGainMetrics(t *testing.T) {
+	N := 4096
+	tests := []struct {
+		name      string
+		window    []float64
+		wantGain  float64
+		wantENBW  float64
+		tolerance float64
+	}{
+		{name: "Rectangular", window: Get(Rectangular)(N), wantGain: 1.0, wantENBW: 1.0, tolerance: 1e-12},
+		{name: "Hann", window: HannWindow(N), wantGain: 0.5, wantENBW: 1.5, tolerance: 1e-3},
+		{name: "Hamming", window: HammingWindow(N), wantGain: 0.54, wantENBW: 1.363, tolerance: 1e-3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gain := CoherentGain(tt.window); math.Abs(gain-tt.wantGain) > tt.tolerance {
+				t.Errorf("CoherentGain() = %f, ожидалось %f", gain, tt.wantGain)
+			}
+			if enbw := EquivalentNoiseBandwidth(tt.window); math.Abs(enbw-tt.wantENBW) > tt.tolerance {
+				t.Errorf("EquivalentNoiseBandwidth() = %f, ожидалось %f", enbw, tt.wantENBW)
+			}
+		})
+	}
+
+	// Пустое окно не должно приводить к NaN
+	if gain := CoherentGain(nil); gain != 0 {
+		t.Errorf("CoherentGain(nil) = %f, ожидалось 0", gain)
+	}
+	if enbw := EquivalentNoiseBandwidth(nil); enbw != 0 {
+		t.Errorf("EquivalentNoiseBandwidth(nil) = %f, ожидалось 0", enbw)
+	}
+}
+
+// TestPeriodicWindows проверяет периодический вариант окон
+func TestPeriodicWindows(t *testing.T) {
+	const N = 64
+
+	// Периодическое окно Ханна длины N равно первым N отсчетам симметричного длины N+1
+	periodic := GetWithMode(Hann, Periodic)(N)
+	symmetric := HannWindow(N + 1)
+	if len(periodic) != N {
+		t.Fatalf("Ожидалась длина %d, получено %d", N, len(periodic))
+	}
+	for n := range periodic {
+		if periodic[n] != symmetric[n] {
+			t.Errorf("Позиция %d: ожидалось %f, получено %f", n, symmetric[n], periodic[n])
+		}
+		if want := 0.5 - 0.5*math.Cos(2*math.Pi*float64(n)/N); math.Abs(periodic[n]-want) > 1e-12 {
+			t.Errorf("Позиция %d: формула с делением на N дает %f, получено %f", n, want, periodic[n])
+		}
+	}
+
+	for _, name := range []Window{Rectangular, Hann, Hamming, Blackman, BlackmanHarris, Bartlett, BartlettHann} {
+		w := GetWithMode(name, Periodic)(N)
+
+		// ДПФ-четная симметрия w[n] = w[N-n], центр окна в N/2
+		for n := 1; n < N; n++ {
+			if math.Abs(w[n]-w[N-n]) > 1e-12 {
+				t.Errorf("%s: нарушена симметрия w[%d] = w[%d]", name, n, N-n)
+				break
+			}
+		}
+		if math.Abs(w[N/2]-1) > 1e-12 {
+			t.Errorf("%s: центр окна %f, ожидалось 1", name, w[N/2])
+		}
+
+		// Симметричный вариант совпадает с Get
+		sym, def := GetWithMode(name, Symmetric)(N), Get(name)(N)
+		for n := range sym {
+			if sym[n] != def[n] {
+				t.Errorf("%s: симметричный вариант отличается от Get в позиции %d", name, n)
+				break
+			}
+		}
+	}
+
+	if single := PeriodicOf(HannWindow)(1); len(single) != 1 || single[0] != 1 {
+		t.Errorf("Окно из одного отсчета: ожидалось [1], получено %v", single)
+	}
+}
+
+// TestBartlettWindow_Triangle проверяет линейность треугольного окна
+func TestBartlettWindow_Triangle(t *testing.T) {
+	// Четная длина: вершина между двумя центральными отсчетами
+	w := BartlettWindow(6)
+	expected := []float64{0, 0.4, 0.8, 0.8, 0.4, 0}
+	for i := range expected {
+		if math.Abs(w[i]-expected[i]) > 1e-12 {
+			t.Errorf("Позиция %d: ожидалось %f, получено %f", i, expected[i], w[i])
+		}
+	}
+
+	// Нечетная длина: шаг 2/(N-1) от края до вершины
+	w = BartlettWindow(9)
+	for n := 0; n <= 4; n++ {
+		if want := float64(n) / 4; math.Abs(w[n]-want) > 1e-12 {
+			t.Errorf("Позиция %d: ожидалось %f, получено %f", n, want, w[n])
+		}
+	}
+}